@@ -0,0 +1,119 @@
+package vimeo
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// Bandwidth limits the transfers of uploads and downloads to a number of bytes per second.
+// It is safe for concurrent use: the transfers sharing a Bandwidth share its rate.
+type Bandwidth struct {
+	rate int64
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewBandwidth returns a Bandwidth limiting transfers to bytesPerSecond.
+// A value lower than 1 doesn't limit transfers.
+func NewBandwidth(bytesPerSecond int64) *Bandwidth {
+	return &Bandwidth{rate: bytesPerSecond}
+}
+
+// Rate returns the number of bytes per second of the Bandwidth.
+func (b *Bandwidth) Rate() int64 {
+	return b.rate
+}
+
+// OptBandwidth is an optional argument to an upload or a download, limiting it to a number
+// of bytes per second instead of Config.Bandwidth. A value lower than 1 doesn't limit the transfer.
+type OptBandwidth int64
+
+func (o OptBandwidth) apply(u *uploadOptions) {
+	u.bandwidth = NewBandwidth(int64(o))
+}
+
+func (o OptBandwidth) applyDownload(d *downloadOptions) {
+	d.bandwidth = NewBandwidth(int64(o))
+}
+
+// limited reports whether the Bandwidth limits transfers.
+func (b *Bandwidth) limited() bool {
+	return b != nil && b.rate > 0
+}
+
+// readSize returns the size of the reads of a transfer, so that
+// they are spread over the second rather than sent in a burst.
+func (b *Bandwidth) readSize() int {
+	n := b.rate / 20
+	if n < 512 {
+		n = 512
+	}
+	return int(n)
+}
+
+// reserve takes n bytes from the bandwidth and returns
+// how long the caller must wait before sending them.
+func (b *Bandwidth) reserve(now time.Time, n int) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	start := now
+	if b.next.After(start) {
+		start = b.next
+	}
+	b.next = start.Add(time.Duration(int64(n) * int64(time.Second) / b.rate))
+
+	return start.Sub(now)
+}
+
+// wait blocks until n bytes may be sent or the context is done.
+func (b *Bandwidth) wait(ctx context.Context, n int) error {
+	d := b.reserve(time.Now(), n)
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reader returns r limited to the bandwidth, or r itself if it is not limited.
+func (b *Bandwidth) reader(ctx context.Context, r io.Reader) io.Reader {
+	if !b.limited() {
+		return r
+	}
+
+	return &bandwidthReader{ctx: ctx, r: r, b: b}
+}
+
+// bandwidthReader is a reader limited to a bandwidth.
+type bandwidthReader struct {
+	ctx context.Context
+	r   io.Reader
+	b   *Bandwidth
+}
+
+func (r *bandwidthReader) Read(p []byte) (int, error) {
+	if size := r.b.readSize(); len(p) > size {
+		p = p[:size]
+	}
+
+	n, err := r.r.Read(p)
+	if n > 0 {
+		if werr := r.b.wait(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+
+	return n, err
+}
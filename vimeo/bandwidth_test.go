@@ -0,0 +1,111 @@
+package vimeo
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBandwidth_reserve(t *testing.T) {
+	b := NewBandwidth(1000)
+	now := time.Now()
+
+	for _, tt := range []struct {
+		n    int
+		want time.Duration
+	}{
+		{500, 0},
+		{500, 500 * time.Millisecond},
+		{1000, time.Second},
+		{100, 2 * time.Second},
+	} {
+		if got := b.reserve(now, tt.n); got != tt.want {
+			t.Errorf("Bandwidth.reserve(%v) returned %v, want %v", tt.n, got, tt.want)
+		}
+	}
+
+	// The bandwidth unused while idle is not accumulated.
+	if got := b.reserve(now.Add(time.Minute), 1000); got != 0 {
+		t.Errorf("Bandwidth.reserve after idle returned %v, want 0", got)
+	}
+}
+
+func TestBandwidth_reader(t *testing.T) {
+	b := NewBandwidth(100000)
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, err := ioutil.ReadAll(b.reader(context.Background(), bytes.NewReader(content)))
+			if err != nil || !bytes.Equal(data, content) {
+				t.Errorf("Bandwidth reader returned %v bytes, %v", len(data), err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 20000 bytes shared at 100000 bytes per second, the first read being immediate.
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("Bandwidth readers took %v, want at least %v", elapsed, 150*time.Millisecond)
+	}
+}
+
+func TestBandwidth_readerCanceled(t *testing.T) {
+	b := NewBandwidth(1000)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := io.Copy(io.Discard, b.reader(ctx, bytes.NewReader(make([]byte, 2000))))
+	if err != context.Canceled {
+		t.Errorf("Bandwidth reader returned %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestBandwidth_unlimited(t *testing.T) {
+	r := bytes.NewReader(nil)
+
+	var b *Bandwidth
+	if got := b.reader(context.Background(), r); got != r {
+		t.Errorf("nil Bandwidth reader returned %#v, want the reader", got)
+	}
+
+	if got := NewBandwidth(0).reader(context.Background(), r); got != r {
+		t.Errorf("Bandwidth(0) reader returned %#v, want the reader", got)
+	}
+}
+
+func TestTusUploader_bandwidth(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 200)
+	tus := &tusServer{t: t, size: int64(len(content))}
+	mux.Handle("/upload", tus)
+
+	client.Config.Bandwidth = NewBandwidth(1000000)
+
+	start := time.Now()
+	ctx := withUploadOptions(context.Background(), client.uploadOptions([]UploadOption{OptBandwidth(10000)}))
+	err := (&TusUploader{}).UploadFromReader(ctx, client, server.URL+"/upload", bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("TusUploader.UploadFromReader returned unexpected error: %v", err)
+	}
+
+	// 2000 bytes at 10000 bytes per second in reads of 512 bytes, the first one being immediate.
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("TusUploader.UploadFromReader took %v, want at least %v", elapsed, 150*time.Millisecond)
+	}
+
+	if !bytes.Equal(tus.data, content) {
+		t.Errorf("Uploaded data is %q, want %q", tus.data, content)
+	}
+}
@@ -1,6 +1,9 @@
 package vimeo
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // CategoriesService handles communication with the categories related
 // methods of the Vimeo API.
@@ -33,13 +36,13 @@ type SubCategory struct {
 	Link string `json:"link,omitempty"`
 }
 
-func listCategory(c *Client, url string, opt ...CallOption) ([]*Category, *Response, error) {
+func listCategory(ctx context.Context, c *Client, url string, opt ...CallOption) ([]*Category, *Response, error) {
 	u, err := addOptions(url, opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -56,13 +59,13 @@ func listCategory(c *Client, url string, opt ...CallOption) ([]*Category, *Respo
 	return categories.Data, resp, err
 }
 
-func getCategory(c *Client, url string, opt ...CallOption) (*Category, *Response, error) {
+func getCategory(ctx context.Context, c *Client, url string, opt ...CallOption) (*Category, *Response, error) {
 	u, err := addOptions(url, opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -81,7 +84,12 @@ func getCategory(c *Client, url string, opt ...CallOption) (*Category, *Response
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/categories#get_categories
 func (s *CategoriesService) List(opt ...CallOption) ([]*Category, *Response, error) {
-	categories, resp, err := listCategory(s.client, "categories", opt...)
+	return s.ListWithContext(context.Background(), opt...)
+}
+
+// ListWithContext method is the same as List, with the addition of the ability to pass a context.
+func (s *CategoriesService) ListWithContext(ctx context.Context, opt ...CallOption) ([]*Category, *Response, error) {
+	categories, resp, err := listCategory(ctx, s.client, "categories", opt...)
 
 	return categories, resp, err
 }
@@ -90,8 +98,13 @@ func (s *CategoriesService) List(opt ...CallOption) ([]*Category, *Response, err
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/categories#get_category
 func (s *CategoriesService) Get(cat string, opt ...CallOption) (*Category, *Response, error) {
+	return s.GetWithContext(context.Background(), cat, opt...)
+}
+
+// GetWithContext method is the same as Get, with the addition of the ability to pass a context.
+func (s *CategoriesService) GetWithContext(ctx context.Context, cat string, opt ...CallOption) (*Category, *Response, error) {
 	u := fmt.Sprintf("categories/%s", cat)
-	category, resp, err := getCategory(s.client, u, opt...)
+	category, resp, err := getCategory(ctx, s.client, u, opt...)
 
 	return category, resp, err
 }
@@ -100,8 +113,13 @@ func (s *CategoriesService) Get(cat string, opt ...CallOption) (*Category, *Resp
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/categories#get_category_channels
 func (s *CategoriesService) ListChannel(cat string, opt ...CallOption) ([]*Channel, *Response, error) {
+	return s.ListChannelWithContext(context.Background(), cat, opt...)
+}
+
+// ListChannelWithContext method is the same as ListChannel, with the addition of the ability to pass a context.
+func (s *CategoriesService) ListChannelWithContext(ctx context.Context, cat string, opt ...CallOption) ([]*Channel, *Response, error) {
 	u := fmt.Sprintf("categories/%s/channels", cat)
-	channels, resp, err := listChannel(s.client, u, opt...)
+	channels, resp, err := listChannel(ctx, s.client, u, opt...)
 
 	return channels, resp, err
 }
@@ -110,8 +128,13 @@ func (s *CategoriesService) ListChannel(cat string, opt ...CallOption) ([]*Chann
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/categories#get_category_groups
 func (s *CategoriesService) ListGroup(cat string, opt ...CallOption) ([]*Group, *Response, error) {
+	return s.ListGroupWithContext(context.Background(), cat, opt...)
+}
+
+// ListGroupWithContext method is the same as ListGroup, with the addition of the ability to pass a context.
+func (s *CategoriesService) ListGroupWithContext(ctx context.Context, cat string, opt ...CallOption) ([]*Group, *Response, error) {
 	u := fmt.Sprintf("categories/%s/groups", cat)
-	groups, resp, err := listGroup(s.client, u, opt...)
+	groups, resp, err := listGroup(ctx, s.client, u, opt...)
 
 	return groups, resp, err
 }
@@ -120,8 +143,13 @@ func (s *CategoriesService) ListGroup(cat string, opt ...CallOption) ([]*Group,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/categories#get_category_videos
 func (s *CategoriesService) ListVideo(cat string, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.ListVideoWithContext(context.Background(), cat, opt...)
+}
+
+// ListVideoWithContext method is the same as ListVideo, with the addition of the ability to pass a context.
+func (s *CategoriesService) ListVideoWithContext(ctx context.Context, cat string, opt ...CallOption) ([]*Video, *Response, error) {
 	u := fmt.Sprintf("categories/%s/videos", cat)
-	videos, resp, err := listVideo(s.client, u, opt...)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
 
 	return videos, resp, err
 }
@@ -130,8 +158,13 @@ func (s *CategoriesService) ListVideo(cat string, opt ...CallOption) ([]*Video,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/categories#check_category_for_video
 func (s *CategoriesService) GetVideo(cat string, vid int, opt ...CallOption) (*Video, *Response, error) {
+	return s.GetVideoWithContext(context.Background(), cat, vid, opt...)
+}
+
+// GetVideoWithContext method is the same as GetVideo, with the addition of the ability to pass a context.
+func (s *CategoriesService) GetVideoWithContext(ctx context.Context, cat string, vid int, opt ...CallOption) (*Video, *Response, error) {
 	u := fmt.Sprintf("categories/%s/videos/%d", cat, vid)
-	video, resp, err := getVideo(s.client, u, opt...)
+	video, resp, err := getVideo(ctx, s.client, u, opt...)
 
 	return video, resp, err
 }
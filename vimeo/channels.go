@@ -1,6 +1,7 @@
 package vimeo
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -46,13 +47,13 @@ func (c Channel) GetID() string {
 	return id
 }
 
-func listChannel(c *Client, url string, opt ...CallOption) ([]*Channel, *Response, error) {
+func listChannel(ctx context.Context, c *Client, url string, opt ...CallOption) ([]*Channel, *Response, error) {
 	u, err := addOptions(url, opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -73,7 +74,12 @@ func listChannel(c *Client, url string, opt ...CallOption) ([]*Channel, *Respons
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#get_channels
 func (s *ChannelsService) List(opt ...CallOption) ([]*Channel, *Response, error) {
-	channels, resp, err := listChannel(s.client, "channels", opt...)
+	return s.ListWithContext(context.Background(), opt...)
+}
+
+// ListWithContext method is the same as List, with the addition of the ability to pass a context.
+func (s *ChannelsService) ListWithContext(ctx context.Context, opt ...CallOption) ([]*Channel, *Response, error) {
+	channels, resp, err := listChannel(ctx, s.client, "channels", opt...)
 
 	return channels, resp, err
 }
@@ -82,7 +88,12 @@ func (s *ChannelsService) List(opt ...CallOption) ([]*Channel, *Response, error)
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#create_channel
 func (s *ChannelsService) Create(r *ChannelRequest) (*Channel, *Response, error) {
-	req, err := s.client.NewRequest("POST", "channels", r)
+	return s.CreateWithContext(context.Background(), r)
+}
+
+// CreateWithContext method is the same as Create, with the addition of the ability to pass a context.
+func (s *ChannelsService) CreateWithContext(ctx context.Context, r *ChannelRequest) (*Channel, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", "channels", r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -100,12 +111,17 @@ func (s *ChannelsService) Create(r *ChannelRequest) (*Channel, *Response, error)
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#get_channel
 func (s *ChannelsService) Get(ch string, opt ...CallOption) (*Channel, *Response, error) {
+	return s.GetWithContext(context.Background(), ch, opt...)
+}
+
+// GetWithContext method is the same as Get, with the addition of the ability to pass a context.
+func (s *ChannelsService) GetWithContext(ctx context.Context, ch string, opt ...CallOption) (*Channel, *Response, error) {
 	u, err := addOptions(fmt.Sprintf("channels/%s", ch), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -124,8 +140,13 @@ func (s *ChannelsService) Get(ch string, opt ...CallOption) (*Channel, *Response
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#edit_channel
 func (s *ChannelsService) Edit(ch string, r *ChannelRequest) (*Channel, *Response, error) {
+	return s.EditWithContext(context.Background(), ch, r)
+}
+
+// EditWithContext method is the same as Edit, with the addition of the ability to pass a context.
+func (s *ChannelsService) EditWithContext(ctx context.Context, ch string, r *ChannelRequest) (*Channel, *Response, error) {
 	u := fmt.Sprintf("channels/%s", ch)
-	req, err := s.client.NewRequest("PATCH", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -143,8 +164,13 @@ func (s *ChannelsService) Edit(ch string, r *ChannelRequest) (*Channel, *Respons
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#delete_channel
 func (s *ChannelsService) Delete(ch string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), ch)
+}
+
+// DeleteWithContext method is the same as Delete, with the addition of the ability to pass a context.
+func (s *ChannelsService) DeleteWithContext(ctx context.Context, ch string) (*Response, error) {
 	u := fmt.Sprintf("channels/%s", ch)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -156,8 +182,13 @@ func (s *ChannelsService) Delete(ch string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#get_channel_subscribers
 func (s *ChannelsService) ListUser(ch string, opt ...CallOption) ([]*User, *Response, error) {
+	return s.ListUserWithContext(context.Background(), ch, opt...)
+}
+
+// ListUserWithContext method is the same as ListUser, with the addition of the ability to pass a context.
+func (s *ChannelsService) ListUserWithContext(ctx context.Context, ch string, opt ...CallOption) ([]*User, *Response, error) {
 	u := fmt.Sprintf("channels/%s/users", ch)
-	users, resp, err := listUser(s.client, u, opt...)
+	users, resp, err := listUser(ctx, s.client, u, opt...)
 
 	return users, resp, err
 }
@@ -166,8 +197,13 @@ func (s *ChannelsService) ListUser(ch string, opt ...CallOption) ([]*User, *Resp
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#get_channel_videos
 func (s *ChannelsService) ListVideo(ch string, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.ListVideoWithContext(context.Background(), ch, opt...)
+}
+
+// ListVideoWithContext method is the same as ListVideo, with the addition of the ability to pass a context.
+func (s *ChannelsService) ListVideoWithContext(ctx context.Context, ch string, opt ...CallOption) ([]*Video, *Response, error) {
 	u := fmt.Sprintf("channels/%s/videos", ch)
-	videos, resp, err := listVideo(s.client, u, opt...)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
 
 	return videos, resp, err
 }
@@ -176,8 +212,13 @@ func (s *ChannelsService) ListVideo(ch string, opt ...CallOption) ([]*Video, *Re
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#get_channel_video
 func (s *ChannelsService) GetVideo(ch string, vid int, opt ...CallOption) (*Video, *Response, error) {
+	return s.GetVideoWithContext(context.Background(), ch, vid, opt...)
+}
+
+// GetVideoWithContext method is the same as GetVideo, with the addition of the ability to pass a context.
+func (s *ChannelsService) GetVideoWithContext(ctx context.Context, ch string, vid int, opt ...CallOption) (*Video, *Response, error) {
 	u := fmt.Sprintf("channels/%s/videos/%d", ch, vid)
-	video, resp, err := getVideo(s.client, u, opt...)
+	video, resp, err := getVideo(ctx, s.client, u, opt...)
 
 	return video, resp, err
 }
@@ -186,8 +227,13 @@ func (s *ChannelsService) GetVideo(ch string, vid int, opt ...CallOption) (*Vide
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#add_video_to_channel
 func (s *ChannelsService) AddVideo(ch string, vid int) (*Video, *Response, error) {
+	return s.AddVideoWithContext(context.Background(), ch, vid)
+}
+
+// AddVideoWithContext method is the same as AddVideo, with the addition of the ability to pass a context.
+func (s *ChannelsService) AddVideoWithContext(ctx context.Context, ch string, vid int) (*Video, *Response, error) {
 	u := fmt.Sprintf("channels/%s/videos/%d", ch, vid)
-	video, resp, err := addVideo(s.client, u)
+	video, resp, err := addVideo(ctx, s.client, u)
 
 	return video, resp, err
 }
@@ -196,8 +242,13 @@ func (s *ChannelsService) AddVideo(ch string, vid int) (*Video, *Response, error
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#delete_video_from_channel
 func (s *ChannelsService) DeleteVideo(ch string, vid int) (*Response, error) {
+	return s.DeleteVideoWithContext(context.Background(), ch, vid)
+}
+
+// DeleteVideoWithContext method is the same as DeleteVideo, with the addition of the ability to pass a context.
+func (s *ChannelsService) DeleteVideoWithContext(ctx context.Context, ch string, vid int) (*Response, error) {
 	u := fmt.Sprintf("channels/%s/videos/%d", ch, vid)
-	resp, err := deleteVideo(s.client, u)
+	resp, err := deleteVideo(ctx, s.client, u)
 
 	return resp, err
 }
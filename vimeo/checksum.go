@@ -0,0 +1,166 @@
+package vimeo
+
+import (
+	"crypto/md5" // nolint: gosec
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// Checksum holds the size and checksums of the bytes of an upload.
+type Checksum struct {
+	Size   int64
+	MD5    string
+	SHA256 string
+}
+
+// OptVerify is an optional argument to an upload, verifying its integrity. The checksums
+// of the bytes are computed while they are uploaded, then compared to the size and MD5 of
+// the source file reported for the video, or the size of the version when replacing the file
+// of a video, when available. If they differ, the upload
+// returns a *VerificationError. If Checksum is not nil, it receives the checksums.
+type OptVerify struct {
+	Checksum *Checksum
+}
+
+func (o OptVerify) apply(u *uploadOptions) {
+	u.verify = &o
+}
+
+// VerificationError reports an upload whose bytes differ from the source file of the video,
+// or a download whose bytes differ from the rendition. It matches ErrVerificationFailed with errors.Is.
+type VerificationError struct {
+	// URI is the URI of the uploaded video or version.
+	URI string
+
+	// Video is the uploaded video, nil when replacing the file of a video.
+	Video *Video
+
+	// Field is the compared value, size or md5.
+	Field string
+
+	// Sent is the value computed from the bytes sent, Received the value reported by Vimeo.
+	// For downloads, Sent is the value reported by Vimeo, Received the value computed from the bytes received.
+	Sent     string
+	Received string
+}
+
+func (e *VerificationError) Error() string {
+	return fmt.Sprintf("vimeo: %v has %v %v, want %v", e.URI, e.Field, e.Received, e.Sent)
+}
+
+// Is reports whether the error matches ErrVerificationFailed.
+func (e *VerificationError) Is(target error) bool {
+	return target == ErrVerificationFailed
+}
+
+// checksum computes the checksums of the bytes written in order to it.
+type checksum struct {
+	n      int64
+	md5    hash.Hash
+	sha256 hash.Hash
+}
+
+func newChecksum() *checksum {
+	return &checksum{
+		md5:    md5.New(), // nolint: gosec
+		sha256: sha256.New(),
+	}
+}
+
+func (c *checksum) Write(p []byte) (int, error) {
+	c.md5.Write(p)    // nolint: errcheck
+	c.sha256.Write(p) // nolint: errcheck
+	c.n += int64(len(p))
+	return len(p), nil
+}
+
+// fill adds the bytes of r following those already written, up to offset to.
+func (c *checksum) fill(r io.ReaderAt, to int64) error {
+	if to <= c.n {
+		return nil
+	}
+
+	_, err := io.Copy(c, io.NewSectionReader(r, c.n, to-c.n))
+	return err
+}
+
+func (c *checksum) result() *Checksum {
+	return &Checksum{
+		Size:   c.n,
+		MD5:    hex.EncodeToString(c.md5.Sum(nil)),
+		SHA256: hex.EncodeToString(c.sha256.Sum(nil)),
+	}
+}
+
+// checksumOf completes the checksum of an upload of size bytes read from r.
+// It returns nil if the upload isn't verified.
+func (o *uploadOptions) checksumOf(r io.Reader, size int64) (*Checksum, error) {
+	if o.verify == nil {
+		return nil, nil
+	}
+
+	// The checksum is computed by TusUploader. Other uploaders only
+	// read the source, which is read again when possible.
+	if o.checksum.n < size {
+		ra, ok := r.(io.ReaderAt)
+		if !ok {
+			return nil, errors.New("vimeo: the checksum of the upload was not computed by the uploader")
+		}
+		if err := o.checksum.fill(ra, size); err != nil {
+			return nil, err
+		}
+	}
+
+	sum := o.checksum.result()
+	if o.verify.Checksum != nil {
+		*o.verify.Checksum = *sum
+	}
+
+	return sum, nil
+}
+
+// verifyVideo compares the checksum of the upload, if any, to the size and MD5 of
+// the source file of the video, or to the size of the upload when the files are not available.
+func verifyVideo(video *Video, sum *Checksum) error {
+	if sum == nil {
+		return nil
+	}
+
+	size, md5 := int64(0), ""
+	for _, f := range video.Files {
+		if f.Quality == "source" {
+			size, md5 = int64(f.Size), f.MD5
+		}
+	}
+	for _, d := range video.Download {
+		if d.Quality == "source" {
+			size, md5 = int64(d.Size), d.Md5
+		}
+	}
+	if size == 0 && video.Upload != nil {
+		size = video.Upload.Size
+	}
+
+	if size != 0 && size != sum.Size {
+		return &VerificationError{URI: video.URI, Video: video, Field: "size", Sent: fmt.Sprint(sum.Size), Received: fmt.Sprint(size)}
+	}
+
+	if md5 != "" && md5 != sum.MD5 {
+		return &VerificationError{URI: video.URI, Video: video, Field: "md5", Sent: sum.MD5, Received: md5}
+	}
+
+	return nil
+}
+
+// verifyVersion compares the checksum of the upload, if any, to the size of the version.
+func verifyVersion(version *Version, sum *Checksum) error {
+	if sum == nil || version.FileSize == 0 || version.FileSize == sum.Size {
+		return nil
+	}
+
+	return &VerificationError{URI: version.URI, Field: "size", Sent: fmt.Sprint(sum.Size), Received: fmt.Sprint(version.FileSize)}
+}
@@ -0,0 +1,109 @@
+package vimeo
+
+import (
+	"bytes"
+	"crypto/md5" // nolint: gosec
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func testChecksum(content []byte) Checksum {
+	m := md5.Sum(content) // nolint: gosec
+	s := sha256.Sum256(content)
+	return Checksum{Size: int64(len(content)), MD5: hex.EncodeToString(m[:]), SHA256: hex.EncodeToString(s[:])}
+}
+
+// verifyServer serves an upload of content, partly received by a previous process,
+// and a video whose source file has the given MD5.
+func verifyServer(t *testing.T, content []byte, received int, md5 string) *tusServer {
+	tus := &tusServer{t: t, size: int64(len(content))}
+	tus.data = append(tus.data, content[:received]...)
+	mux.Handle("/upload", tus)
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"uri": "/videos/1", "upload": {"approach": "tus", "upload_link": "%s/upload"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"uri": "/videos/1", "files": [{"quality": "hd", "size": 1, "md5": "x"}, {"quality": "source", "size": %d, "md5": %q}]}`, len(content), md5)
+	})
+
+	return tus
+}
+
+func TestUsersService_UploadVideo_verify(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	want := testChecksum(content)
+
+	readers := map[string]func() io.Reader{
+		"reader at": func() io.Reader { return bytes.NewReader(content) },
+		"stream":    func() io.Reader { return streamReader{bytes.NewReader(content)} },
+	}
+
+	for name, reader := range readers {
+		for _, received := range []int{0, 45, 100} {
+			setup()
+			verifyServer(t, content, received, want.MD5)
+			client.Config.Uploader = &TusUploader{ChunkSize: 30}
+
+			var sum Checksum
+			_, _, err := client.Users.UploadVideoFromReader("", reader(), int64(len(content)), OptVerify{Checksum: &sum})
+			if err != nil {
+				t.Errorf("Users.UploadVideoFromReader from %v received at %v returned unexpected error: %v", name, received, err)
+			}
+
+			if sum != want {
+				t.Errorf("Users.UploadVideoFromReader from %v received at %v computed %+v, want %+v", name, received, sum, want)
+			}
+
+			teardown()
+		}
+	}
+}
+
+func TestUsersService_UploadVideo_verifyFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := []byte("0123456789")
+	verifyServer(t, content, 0, "d41d8cd98f00b204e9800998ecf8427e")
+
+	_, _, err := client.Users.UploadVideo("", testFile(t, content), OptVerify{})
+	if !IsVerificationFailed(err) {
+		t.Fatalf("Users.UploadVideo returned %v, want a verification error", err)
+	}
+
+	if e := err.(*VerificationError); e.Field != "md5" || e.Received != "d41d8cd98f00b204e9800998ecf8427e" || e.Sent != testChecksum(content).MD5 {
+		t.Errorf("Users.UploadVideo returned %+v", e)
+	}
+}
+
+func TestUsersService_UploadVideo_verifyFileUploader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := []byte("0123456789")
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"uri": "/videos/1", "upload": {"approach": "tus", "upload_link": "%s/upload"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1", "upload": {"size": 9}}`)
+	})
+
+	client.Config.Uploader = fileUploader{}
+
+	var sum Checksum
+	_, _, err := client.Users.UploadVideo("", testFile(t, content), OptVerify{Checksum: &sum})
+	if e, ok := err.(*VerificationError); !ok || e.Field != "size" {
+		t.Errorf("Users.UploadVideo returned %v, want a size verification error", err)
+	}
+
+	if want := testChecksum(content); sum != want {
+		t.Errorf("Users.UploadVideo computed %+v, want %+v", sum, want)
+	}
+}
@@ -1,14 +1,56 @@
 package vimeo
 
+import "log/slog"
+
 // Config provides a way to configure the Client depending on your needs.
 type Config struct {
 	// Uploader
 	Uploader Uploader
+
+	// CheckQuota, if true, fetches the upload quota of the user before the upload of a new
+	// video, which fails with a *QuotaExceededError if the video is too large. It costs a
+	// request per upload, and a token allowed to read the user. New versions of a video
+	// aren't checked. It is disabled by default.
+	CheckQuota bool
+
+	// SessionStore records the uploads in progress, so that they can be resumed
+	// after the process restarts. A nil value disables the sessions.
+	SessionStore SessionStore
+
+	// Bandwidth limits the transfers of uploads and downloads, unless replaced with OptBandwidth.
+	// A nil value doesn't limit transfers.
+	Bandwidth *Bandwidth
+
+	// Progress receives the progress of every upload, unless replaced with OptProgress.
+	Progress ProgressFunc
+
+	// Retry controls how failed requests are retried.
+	// A nil value disables retries.
+	Retry *RetryPolicy
+
+	// RateLimiter paces requests as the API rate limit runs out.
+	// A nil value disables client-side rate limiting.
+	RateLimiter *RateLimiter
+
+	// Middleware wraps every call of Client.Do and the requests of downloads, the first one being the outermost.
+	Middleware []Middleware
+
+	// Logger receives a debug record for every API call and upload.
+	// A nil value disables logging.
+	Logger *slog.Logger
+
+	// LogBodies adds the JSON request and response bodies to the log records.
+	LogBodies bool
+
+	// Redaction hides secrets from the log records.
+	// A nil value uses DefaultRedaction.
+	Redaction *Redaction
 }
 
 // DefaultConfig return the default Client configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		Uploader: nil,
+		Uploader: &TusUploader{ChunkSize: DefaultChunkSize, MaxResumes: 3},
+		Retry:    DefaultRetryPolicy(),
 	}
 }
@@ -1,5 +1,7 @@
 package vimeo
 
+import "context"
+
 // ContentRatingsService handles communication with the content ratings related
 // methods of the Vimeo API.
 //
@@ -22,12 +24,17 @@ type ContentRating struct {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/contentratings
 func (s *ContentRatingsService) List(opt ...CallOption) ([]*ContentRating, *Response, error) {
+	return s.ListWithContext(context.Background(), opt...)
+}
+
+// ListWithContext method is the same as List, with the addition of the ability to pass a context.
+func (s *ContentRatingsService) ListWithContext(ctx context.Context, opt ...CallOption) ([]*ContentRating, *Response, error) {
 	u, err := addOptions("contentratings", opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1,5 +1,7 @@
 package vimeo
 
+import "context"
+
 // CreativeCommonsService handles communication with the creative commons related
 // methods of the Vimeo API.
 //
@@ -22,12 +24,17 @@ type CreativeCommon struct {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_cc_licenses
 func (s *CreativeCommonsService) List(opt ...CallOption) ([]*CreativeCommon, *Response, error) {
+	return s.ListWithContext(context.Background(), opt...)
+}
+
+// ListWithContext method is the same as List, with the addition of the ability to pass a context.
+func (s *CreativeCommonsService) ListWithContext(ctx context.Context, opt ...CallOption) ([]*CreativeCommon, *Response, error) {
 	u, err := addOptions("creativecommons", opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
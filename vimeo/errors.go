@@ -0,0 +1,87 @@
+package vimeo
+
+import "errors"
+
+// Sentinel errors matched by ErrorResponse and RateLimitError with errors.Is.
+var (
+	// ErrNotFound matches API errors with status 404 Not Found.
+	ErrNotFound = errors.New("vimeo: not found")
+	// ErrUnauthorized matches API errors with status 401 Unauthorized.
+	ErrUnauthorized = errors.New("vimeo: unauthorized")
+	// ErrForbidden matches API errors with status 403 Forbidden.
+	ErrForbidden = errors.New("vimeo: forbidden")
+	// ErrRateLimited matches API errors with status 429 Too Many Requests.
+	ErrRateLimited = errors.New("vimeo: rate limited")
+	// ErrValidation matches API errors reporting invalid parameters.
+	ErrValidation = errors.New("vimeo: validation failed")
+)
+
+// Sentinel errors matched by TranscodeError with errors.Is.
+var (
+	// ErrUploadFailed matches videos whose upload failed or exceeded the quota.
+	ErrUploadFailed = errors.New("vimeo: upload failed")
+	// ErrFetchFailed matches videos uploaded from a URL which Vimeo could not fetch.
+	ErrFetchFailed = errors.New("vimeo: fetch failed")
+	// ErrTranscodeFailed matches videos whose transcode failed.
+	ErrTranscodeFailed = errors.New("vimeo: transcode failed")
+)
+
+// ErrQuotaExceeded matches QuotaExceededError, and TranscodeError for videos over the quota, with errors.Is.
+var ErrQuotaExceeded = errors.New("vimeo: upload quota exceeded")
+
+// ErrVerificationFailed matches VerificationError with errors.Is.
+var ErrVerificationFailed = errors.New("vimeo: verification failed")
+
+// ErrNoDownload is returned by downloads of videos without a rendition matching the options.
+var ErrNoDownload = errors.New("vimeo: no download matches the options")
+
+// IsNotFound reports whether err is an API error for a missing resource.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUnauthorized reports whether err is an API error for a missing or invalid token.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsForbidden reports whether err is an API error for a request without permission.
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsRateLimited reports whether err is an API error for an exceeded rate limit.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsValidation reports whether err is an API error for rejected request fields.
+// Use errors.As with *ErrorResponse to read the InvalidParameters.
+func IsValidation(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// IsUploadFailed reports whether err is the failure of a video upload reported by WaitForTranscode.
+func IsUploadFailed(err error) bool {
+	return errors.Is(err, ErrUploadFailed)
+}
+
+// IsFetchFailed reports whether err is the failure of Vimeo to fetch the file of a video uploaded from a URL.
+func IsFetchFailed(err error) bool {
+	return errors.Is(err, ErrFetchFailed)
+}
+
+// IsTranscodeFailed reports whether err is the failure of a video transcode reported by WaitForTranscode.
+func IsTranscodeFailed(err error) bool {
+	return errors.Is(err, ErrTranscodeFailed)
+}
+
+// IsVerificationFailed reports whether err is an upload or download whose bytes differ from the video known to Vimeo.
+func IsVerificationFailed(err error) bool {
+	return errors.Is(err, ErrVerificationFailed)
+}
+
+// IsQuotaExceeded reports whether err is an upload exceeding the upload quota of the user.
+func IsQuotaExceeded(err error) bool {
+	return errors.Is(err, ErrQuotaExceeded)
+}
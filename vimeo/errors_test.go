@@ -0,0 +1,132 @@
+package vimeo
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestErrorResponse_payload(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{
+			"error": "You have provided an invalid parameter.",
+			"link": null,
+			"developer_message": "The parameters passed to this API endpoint did not pass Vimeo's validation.",
+			"error_code": 2204,
+			"invalid_parameters": [{
+				"field": "privacy.view",
+				"error": "The privacy setting is invalid.",
+				"developer_message": "privacy.view must be one of: anybody, nobody.",
+				"error_code": 2204
+			}]
+		}`)
+	})
+
+	_, _, err := client.Videos.Edit(1, &VideoRequest{Name: "name"})
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Expected a *ErrorResponse error; got %#v.", err)
+	}
+
+	if errResp.ErrorCode != 2204 {
+		t.Errorf("ErrorResponse.ErrorCode is %v, want %v", errResp.ErrorCode, 2204)
+	}
+
+	if !strings.HasPrefix(errResp.DeveloperMessage, "The parameters") {
+		t.Errorf("ErrorResponse.DeveloperMessage is %v", errResp.DeveloperMessage)
+	}
+
+	want := []*InvalidParameter{{
+		Field:            "privacy.view",
+		Message:          "The privacy setting is invalid.",
+		DeveloperMessage: "privacy.view must be one of: anybody, nobody.",
+		ErrorCode:        2204,
+	}}
+	if !reflect.DeepEqual(errResp.InvalidParameters, want) {
+		t.Errorf("ErrorResponse.InvalidParameters is %+v, want %+v", errResp.InvalidParameters, want)
+	}
+
+	if !IsValidation(err) {
+		t.Errorf("IsValidation returned false for %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "privacy.view: The privacy setting is invalid.") {
+		t.Errorf("ErrorResponse.Error does not mention the invalid field: %v", err)
+	}
+}
+
+func TestErrorResponse_Is(t *testing.T) {
+	tests := []struct {
+		status int
+		check  func(error) bool
+	}{
+		{http.StatusNotFound, IsNotFound},
+		{http.StatusUnauthorized, IsUnauthorized},
+		{http.StatusForbidden, IsForbidden},
+		{http.StatusTooManyRequests, IsRateLimited},
+	}
+
+	for _, tt := range tests {
+		err := CheckResponse(&http.Response{
+			Request:    &http.Request{},
+			StatusCode: tt.status,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"error": "error"}`)),
+		})
+
+		for _, other := range tests {
+			if got, want := other.check(err), other.status == tt.status; got != want {
+				t.Errorf("Error with status %v matches %v: %v, want %v", tt.status, other.status, got, want)
+			}
+		}
+
+		if IsValidation(err) {
+			t.Errorf("IsValidation returned true for status %v", tt.status)
+		}
+	}
+}
+
+func TestRateLimitError_Is(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"error": "Too many requests", "error_code": 9000}`)),
+	}
+	res.Header.Set(headerRateRemaining, "0")
+
+	err := CheckResponse(res)
+	if _, ok := err.(*RateLimitError); !ok {
+		t.Fatalf("Expected a *RateLimitError error; got %#v.", err)
+	}
+
+	if !IsRateLimited(err) {
+		t.Errorf("IsRateLimited returned false for %v", err)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.ErrorCode != 9000 {
+		t.Errorf("RateLimitError does not unwrap to the ErrorResponse: %#v", errResp)
+	}
+}
+
+func TestCheckResponse_emptyBody(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusNotFound,
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}
+
+	if err := CheckResponse(res); !IsNotFound(err) {
+		t.Errorf("CheckResponse returned %#v, want not found ErrorResponse", err)
+	}
+}
@@ -0,0 +1,361 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FoldersService handles communication with the folder related
+// methods of the Vimeo API. Folders are called projects by the API.
+// Passing the empty string as uid uses the authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders
+type FoldersService service
+
+type dataListFolder struct {
+	Data []*Folder `json:"data"`
+	pagination
+}
+
+type dataListFolderItem struct {
+	Data []*FolderItem `json:"data"`
+	pagination
+}
+
+// Connection internal object provides access to a resource related to another.
+type Connection struct {
+	URI     string   `json:"uri,omitempty"`
+	Options []string `json:"options,omitempty"`
+	Total   int      `json:"total,omitempty"`
+}
+
+// FolderConnections internal object provides access to the resources related to a folder.
+type FolderConnections struct {
+	Folders      *Connection `json:"folders,omitempty"`
+	Items        *Connection `json:"items,omitempty"`
+	Videos       *Connection `json:"videos,omitempty"`
+	ParentFolder *Connection `json:"parent_folder,omitempty"`
+}
+
+// FolderMetadata internal object provides access to the metadata of a folder.
+type FolderMetadata struct {
+	Connections *FolderConnections `json:"connections,omitempty"`
+}
+
+// Folder represents a folder.
+type Folder struct {
+	URI                     string          `json:"uri,omitempty"`
+	Name                    string          `json:"name,omitempty"`
+	Link                    string          `json:"link,omitempty"`
+	ResourceKey             string          `json:"resource_key,omitempty"`
+	CreatedTime             time.Time       `json:"created_time,omitempty"`
+	ModifiedTime            time.Time       `json:"modified_time,omitempty"`
+	LastUserActionEventDate time.Time       `json:"last_user_action_event_date,omitempty"`
+	Privacy                 *Privacy        `json:"privacy,omitempty"`
+	User                    *User           `json:"user,omitempty"`
+	Metadata                *FolderMetadata `json:"metadata,omitempty"`
+}
+
+// FolderRequest represents a request to create/edit a folder.
+// ParentFolderURI creates the folder in another one, or moves it there.
+type FolderRequest struct {
+	Name            string `json:"name,omitempty"`
+	ParentFolderURI string `json:"parent_folder_uri,omitempty"`
+}
+
+// FolderItem represents an item of a folder: a subfolder or a video, depending on Type.
+type FolderItem struct {
+	Type   string  `json:"type,omitempty"`
+	Folder *Folder `json:"folder,omitempty"`
+	Video  *Video  `json:"video,omitempty"`
+}
+
+// GetID returns the numeric identifier (ID) of the folder.
+func (f Folder) GetID() int {
+	l := strings.Split(f.URI, "/")
+	ID, _ := strconv.Atoi(l[len(l)-1])
+	return ID
+}
+
+// ParentURI returns the URI of the parent folder, or the empty string for a folder at the root.
+func (f Folder) ParentURI() string {
+	if f.Metadata == nil || f.Metadata.Connections == nil || f.Metadata.Connections.ParentFolder == nil {
+		return ""
+	}
+	return f.Metadata.Connections.ParentFolder.URI
+}
+
+// OptDeleteVideos is an optional argument to FoldersService.Delete,
+// deleting the videos of the folder along with it.
+type OptDeleteVideos bool
+
+// Get key/value for make query
+func (o OptDeleteVideos) Get() (string, string) {
+	return "should_delete_clips", fmt.Sprint(o)
+}
+
+// optURIs sends the URIs of the videos of a bulk request.
+type optURIs []string
+
+func (o optURIs) Get() (string, string) {
+	return "uris", strings.Join(o, ",")
+}
+
+func videoURIs(vids []int) optURIs {
+	uris := make(optURIs, len(vids))
+	for i, vid := range vids {
+		uris[i] = fmt.Sprintf("/videos/%d", vid)
+	}
+	return uris
+}
+
+// folderURL returns the URL of the folders of the specified user, followed by path.
+func folderURL(uid string, path string) string {
+	if uid == "" {
+		return "me/projects" + path
+	}
+	return fmt.Sprintf("users/%s/projects%s", uid, path)
+}
+
+// List method gets all the folders of the specified user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#get_projects
+func (s *FoldersService) List(uid string, opt ...CallOption) ([]*Folder, *Response, error) {
+	return s.ListWithContext(context.Background(), uid, opt...)
+}
+
+// ListWithContext method is the same as List, with the addition of the ability to pass a context.
+func (s *FoldersService) ListWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*Folder, *Response, error) {
+	u, err := addOptions(folderURL(uid, ""), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	folders := &dataListFolder{}
+
+	resp, err := s.client.Do(req, folders)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(folders)
+
+	return folders.Data, resp, err
+}
+
+// Create method creates a new folder for the specified user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#create_project
+func (s *FoldersService) Create(uid string, r *FolderRequest) (*Folder, *Response, error) {
+	return s.CreateWithContext(context.Background(), uid, r)
+}
+
+// CreateWithContext method is the same as Create, with the addition of the ability to pass a context.
+func (s *FoldersService) CreateWithContext(ctx context.Context, uid string, r *FolderRequest) (*Folder, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", folderURL(uid, ""), r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	folder := &Folder{}
+	resp, err := s.client.Do(req, folder)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return folder, resp, nil
+}
+
+// Get method gets a single folder.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#get_project
+func (s *FoldersService) Get(uid string, fid int, opt ...CallOption) (*Folder, *Response, error) {
+	return s.GetWithContext(context.Background(), uid, fid, opt...)
+}
+
+// GetWithContext method is the same as Get, with the addition of the ability to pass a context.
+func (s *FoldersService) GetWithContext(ctx context.Context, uid string, fid int, opt ...CallOption) (*Folder, *Response, error) {
+	u, err := addOptions(folderURL(uid, fmt.Sprintf("/%d", fid)), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	folder := &Folder{}
+
+	resp, err := s.client.Do(req, folder)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return folder, resp, err
+}
+
+// Edit method edits a folder, renaming it or moving it to another folder.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#edit_project
+func (s *FoldersService) Edit(uid string, fid int, r *FolderRequest) (*Folder, *Response, error) {
+	return s.EditWithContext(context.Background(), uid, fid, r)
+}
+
+// EditWithContext method is the same as Edit, with the addition of the ability to pass a context.
+func (s *FoldersService) EditWithContext(ctx context.Context, uid string, fid int, r *FolderRequest) (*Folder, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", folderURL(uid, fmt.Sprintf("/%d", fid)), r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	folder := &Folder{}
+	resp, err := s.client.Do(req, folder)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return folder, resp, nil
+}
+
+// Delete method deletes a folder. Its videos are kept, unless OptDeleteVideos(true) is passed.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#delete_project
+func (s *FoldersService) Delete(uid string, fid int, opt ...CallOption) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), uid, fid, opt...)
+}
+
+// DeleteWithContext method is the same as Delete, with the addition of the ability to pass a context.
+func (s *FoldersService) DeleteWithContext(ctx context.Context, uid string, fid int, opt ...CallOption) (*Response, error) {
+	u, err := addOptions(folderURL(uid, fmt.Sprintf("/%d", fid)), opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListItem method gets the subfolders and videos of a folder.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#get_project_items
+func (s *FoldersService) ListItem(uid string, fid int, opt ...CallOption) ([]*FolderItem, *Response, error) {
+	return s.ListItemWithContext(context.Background(), uid, fid, opt...)
+}
+
+// ListItemWithContext method is the same as ListItem, with the addition of the ability to pass a context.
+func (s *FoldersService) ListItemWithContext(ctx context.Context, uid string, fid int, opt ...CallOption) ([]*FolderItem, *Response, error) {
+	u, err := addOptions(folderURL(uid, fmt.Sprintf("/%d/items", fid)), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items := &dataListFolderItem{}
+
+	resp, err := s.client.Do(req, items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(items)
+
+	return items.Data, resp, err
+}
+
+// ListVideo method gets all the videos of a folder.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#get_project_videos
+func (s *FoldersService) ListVideo(uid string, fid int, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.ListVideoWithContext(context.Background(), uid, fid, opt...)
+}
+
+// ListVideoWithContext method is the same as ListVideo, with the addition of the ability to pass a context.
+func (s *FoldersService) ListVideoWithContext(ctx context.Context, uid string, fid int, opt ...CallOption) ([]*Video, *Response, error) {
+	videos, resp, err := listVideo(ctx, s.client, folderURL(uid, fmt.Sprintf("/%d/videos", fid)), opt...)
+
+	return videos, resp, err
+}
+
+// AddVideo method adds a single video to a folder.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#add_video_to_project
+func (s *FoldersService) AddVideo(uid string, fid int, vid int) (*Response, error) {
+	return s.AddVideoWithContext(context.Background(), uid, fid, vid)
+}
+
+// AddVideoWithContext method is the same as AddVideo, with the addition of the ability to pass a context.
+func (s *FoldersService) AddVideoWithContext(ctx context.Context, uid string, fid int, vid int) (*Response, error) {
+	_, resp, err := addVideo(ctx, s.client, folderURL(uid, fmt.Sprintf("/%d/videos/%d", fid, vid)))
+
+	return resp, err
+}
+
+// AddVideos method adds multiple videos to a folder in a single request.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#add_videos_to_project
+func (s *FoldersService) AddVideos(uid string, fid int, vids []int) (*Response, error) {
+	return s.AddVideosWithContext(context.Background(), uid, fid, vids)
+}
+
+// AddVideosWithContext method is the same as AddVideos, with the addition of the ability to pass a context.
+func (s *FoldersService) AddVideosWithContext(ctx context.Context, uid string, fid int, vids []int) (*Response, error) {
+	u, err := addOptions(folderURL(uid, fmt.Sprintf("/%d/videos", fid)), videoURIs(vids))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteVideo method removes a video from a folder. The video isn't deleted.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#remove_video_from_project
+func (s *FoldersService) DeleteVideo(uid string, fid int, vid int) (*Response, error) {
+	return s.DeleteVideoWithContext(context.Background(), uid, fid, vid)
+}
+
+// DeleteVideoWithContext method is the same as DeleteVideo, with the addition of the ability to pass a context.
+func (s *FoldersService) DeleteVideoWithContext(ctx context.Context, uid string, fid int, vid int) (*Response, error) {
+	resp, err := deleteVideo(ctx, s.client, folderURL(uid, fmt.Sprintf("/%d/videos/%d", fid, vid)))
+
+	return resp, err
+}
+
+// DeleteVideos method removes multiple videos from a folder in a single request. The videos aren't deleted.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#remove_videos_from_project
+func (s *FoldersService) DeleteVideos(uid string, fid int, vids []int) (*Response, error) {
+	return s.DeleteVideosWithContext(context.Background(), uid, fid, vids)
+}
+
+// DeleteVideosWithContext method is the same as DeleteVideos, with the addition of the ability to pass a context.
+func (s *FoldersService) DeleteVideosWithContext(ctx context.Context, uid string, fid int, vids []int) (*Response, error) {
+	u, err := addOptions(folderURL(uid, fmt.Sprintf("/%d/videos", fid)), videoURIs(vids))
+	if err != nil {
+		return nil, err
+	}
+
+	return deleteVideo(ctx, s.client, u)
+}
@@ -0,0 +1,254 @@
+package vimeo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestFolder_GetID(t *testing.T) {
+	v := &Folder{Name: "Test", URI: "/users/1/projects/2"}
+
+	if id := v.GetID(); id != 2 {
+		t.Errorf("Folder.GetID returned %+v, want %+v", id, 2)
+	}
+}
+
+func TestFoldersService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	folders, _, err := client.Folders.List("", OptPage(1), OptPerPage(2))
+	if err != nil {
+		t.Errorf("Folders.List returned unexpected error: %v", err)
+	}
+
+	want := []*Folder{{Name: "Test"}}
+	if !reflect.DeepEqual(folders, want) {
+		t.Errorf("Folders.List returned %+v, want %+v", folders, want)
+	}
+}
+
+func TestFoldersService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &FolderRequest{
+		Name:            "name",
+		ParentFolderURI: "/users/1/projects/1",
+	}
+
+	mux.HandleFunc("/users/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		v := &FolderRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("Folders.Create returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Folders.Create body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"uri": "/users/1/projects/2", "name": "name", "metadata": {"connections": {"parent_folder": {"uri": "/users/1/projects/1"}}}}`)
+	})
+
+	folder, _, err := client.Folders.Create("1", input)
+	if err != nil {
+		t.Errorf("Folders.Create returned unexpected error: %v", err)
+	}
+
+	if folder.Name != "name" || folder.ParentURI() != "/users/1/projects/1" {
+		t.Errorf("Folders.Create returned %+v, want a subfolder of %v", folder, "/users/1/projects/1")
+	}
+}
+
+func TestFoldersService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	folder, _, err := client.Folders.Get("1", 2)
+	if err != nil {
+		t.Errorf("Folders.Get returned unexpected error: %v", err)
+	}
+
+	want := &Folder{Name: "Test"}
+	if !reflect.DeepEqual(folder, want) {
+		t.Errorf("Folders.Get returned %+v, want %+v", folder, want)
+	}
+
+	if folder.ParentURI() != "" {
+		t.Errorf("Folder.ParentURI returned %+v, want %+v", folder.ParentURI(), "")
+	}
+}
+
+func TestFoldersService_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &FolderRequest{
+		Name: "name",
+	}
+
+	mux.HandleFunc("/me/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		v := &FolderRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("Folders.Edit returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Folders.Edit body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"name": "name"}`)
+	})
+
+	folder, _, err := client.Folders.Edit("", 2, input)
+	if err != nil {
+		t.Errorf("Folders.Edit returned unexpected error: %v", err)
+	}
+
+	want := &Folder{Name: "name"}
+	if !reflect.DeepEqual(folder, want) {
+		t.Errorf("Folders.Edit returned %+v, want %+v", folder, want)
+	}
+}
+
+func TestFoldersService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testFormURLValues(t, r, values{
+			"should_delete_clips": "true",
+		})
+	})
+
+	_, err := client.Folders.Delete("", 2, OptDeleteVideos(true))
+	if err != nil {
+		t.Errorf("Folders.Delete returned unexpected error: %v", err)
+	}
+}
+
+func TestFoldersService_ListItem(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/projects/2/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"type": "folder", "folder": {"name": "Sub"}}, {"type": "video", "video": {"name": "Test"}}]}`)
+	})
+
+	items, _, err := client.Folders.ListItem("", 2)
+	if err != nil {
+		t.Errorf("Folders.ListItem returned unexpected error: %v", err)
+	}
+
+	want := []*FolderItem{{Type: "folder", Folder: &Folder{Name: "Sub"}}, {Type: "video", Video: &Video{Name: "Test"}}}
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("Folders.ListItem returned %+v, want %+v", items, want)
+	}
+}
+
+func TestFoldersService_ListVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/projects/2/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	videos, _, err := client.Folders.ListVideo("", 2)
+	if err != nil {
+		t.Errorf("Folders.ListVideo returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("Folders.ListVideo returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestFoldersService_AddVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/projects/2/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Folders.AddVideo("", 2, 1)
+	if err != nil {
+		t.Errorf("Folders.AddVideo returned unexpected error: %v", err)
+	}
+}
+
+func TestFoldersService_AddVideos(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/projects/2/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testFormURLValues(t, r, values{
+			"uris": "/videos/1,/videos/3",
+		})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Folders.AddVideos("", 2, []int{1, 3})
+	if err != nil {
+		t.Errorf("Folders.AddVideos returned unexpected error: %v", err)
+	}
+}
+
+func TestFoldersService_DeleteVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/projects/2/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Folders.DeleteVideo("", 2, 1)
+	if err != nil {
+		t.Errorf("Folders.DeleteVideo returned unexpected error: %v", err)
+	}
+}
+
+func TestFoldersService_DeleteVideos(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/projects/2/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testFormURLValues(t, r, values{
+			"uris": "/videos/1,/videos/3",
+		})
+	})
+
+	_, err := client.Folders.DeleteVideos("", 2, []int{1, 3})
+	if err != nil {
+		t.Errorf("Folders.DeleteVideos returned unexpected error: %v", err)
+	}
+}
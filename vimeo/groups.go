@@ -1,6 +1,7 @@
 package vimeo
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -45,13 +46,13 @@ func (g Group) GetID() string {
 	return id
 }
 
-func listGroup(c *Client, url string, opt ...CallOption) ([]*Group, *Response, error) {
+func listGroup(ctx context.Context, c *Client, url string, opt ...CallOption) ([]*Group, *Response, error) {
 	u, err := addOptions(url, opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -72,7 +73,12 @@ func listGroup(c *Client, url string, opt ...CallOption) ([]*Group, *Response, e
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/groups#get_groups
 func (s *GroupsService) List(opt ...CallOption) ([]*Group, *Response, error) {
-	groups, resp, err := listGroup(s.client, "groups", opt...)
+	return s.ListWithContext(context.Background(), opt...)
+}
+
+// ListWithContext method is the same as List, with the addition of the ability to pass a context.
+func (s *GroupsService) ListWithContext(ctx context.Context, opt ...CallOption) ([]*Group, *Response, error) {
+	groups, resp, err := listGroup(ctx, s.client, "groups", opt...)
 
 	return groups, resp, err
 }
@@ -81,7 +87,12 @@ func (s *GroupsService) List(opt ...CallOption) ([]*Group, *Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/groups#create_group
 func (s *GroupsService) Create(r *GroupRequest) (*Group, *Response, error) {
-	req, err := s.client.NewRequest("POST", "groups", r)
+	return s.CreateWithContext(context.Background(), r)
+}
+
+// CreateWithContext method is the same as Create, with the addition of the ability to pass a context.
+func (s *GroupsService) CreateWithContext(ctx context.Context, r *GroupRequest) (*Group, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", "groups", r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -99,12 +110,17 @@ func (s *GroupsService) Create(r *GroupRequest) (*Group, *Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/groups#get_group
 func (s *GroupsService) Get(gr string, opt ...CallOption) (*Group, *Response, error) {
+	return s.GetWithContext(context.Background(), gr, opt...)
+}
+
+// GetWithContext method is the same as Get, with the addition of the ability to pass a context.
+func (s *GroupsService) GetWithContext(ctx context.Context, gr string, opt ...CallOption) (*Group, *Response, error) {
 	u, err := addOptions(fmt.Sprintf("groups/%s", gr), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -123,8 +139,13 @@ func (s *GroupsService) Get(gr string, opt ...CallOption) (*Group, *Response, er
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/groups#delete_group
 func (s *GroupsService) Delete(gr string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), gr)
+}
+
+// DeleteWithContext method is the same as Delete, with the addition of the ability to pass a context.
+func (s *GroupsService) DeleteWithContext(ctx context.Context, gr string) (*Response, error) {
 	u := fmt.Sprintf("groups/%s", gr)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -136,8 +157,13 @@ func (s *GroupsService) Delete(gr string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/groups#get_group_members
 func (s *GroupsService) ListUser(gr string, opt ...CallOption) ([]*User, *Response, error) {
+	return s.ListUserWithContext(context.Background(), gr, opt...)
+}
+
+// ListUserWithContext method is the same as ListUser, with the addition of the ability to pass a context.
+func (s *GroupsService) ListUserWithContext(ctx context.Context, gr string, opt ...CallOption) ([]*User, *Response, error) {
 	u := fmt.Sprintf("groups/%s/users", gr)
-	users, resp, err := listUser(s.client, u, opt...)
+	users, resp, err := listUser(ctx, s.client, u, opt...)
 
 	return users, resp, err
 }
@@ -146,8 +172,13 @@ func (s *GroupsService) ListUser(gr string, opt ...CallOption) ([]*User, *Respon
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/groups#get_group_videos
 func (s *GroupsService) ListVideo(gr string, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.ListVideoWithContext(context.Background(), gr, opt...)
+}
+
+// ListVideoWithContext method is the same as ListVideo, with the addition of the ability to pass a context.
+func (s *GroupsService) ListVideoWithContext(ctx context.Context, gr string, opt ...CallOption) ([]*Video, *Response, error) {
 	u := fmt.Sprintf("groups/%s/videos", gr)
-	videos, resp, err := listVideo(s.client, u, opt...)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
 
 	return videos, resp, err
 }
@@ -156,8 +187,13 @@ func (s *GroupsService) ListVideo(gr string, opt ...CallOption) ([]*Video, *Resp
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/groups#get_group_video
 func (s *GroupsService) GetVideo(gr string, vid int, opt ...CallOption) (*Video, *Response, error) {
+	return s.GetVideoWithContext(context.Background(), gr, vid, opt...)
+}
+
+// GetVideoWithContext method is the same as GetVideo, with the addition of the ability to pass a context.
+func (s *GroupsService) GetVideoWithContext(ctx context.Context, gr string, vid int, opt ...CallOption) (*Video, *Response, error) {
 	u := fmt.Sprintf("groups/%s/videos/%d", gr, vid)
-	video, resp, err := getVideo(s.client, u, opt...)
+	video, resp, err := getVideo(ctx, s.client, u, opt...)
 
 	return video, resp, err
 }
@@ -166,8 +202,13 @@ func (s *GroupsService) GetVideo(gr string, vid int, opt ...CallOption) (*Video,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/groups#add_video_to_group
 func (s *GroupsService) AddVideo(gr string, vid int, opt ...CallOption) (*Video, *Response, error) {
+	return s.AddVideoWithContext(context.Background(), gr, vid, opt...)
+}
+
+// AddVideoWithContext method is the same as AddVideo, with the addition of the ability to pass a context.
+func (s *GroupsService) AddVideoWithContext(ctx context.Context, gr string, vid int, opt ...CallOption) (*Video, *Response, error) {
 	u := fmt.Sprintf("groups/%s/videos/%d", gr, vid)
-	video, resp, err := addVideo(s.client, u)
+	video, resp, err := addVideo(ctx, s.client, u)
 
 	return video, resp, err
 }
@@ -176,8 +217,13 @@ func (s *GroupsService) AddVideo(gr string, vid int, opt ...CallOption) (*Video,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/groups#delete_video_from_group
 func (s *GroupsService) DeleteVideo(gr string, vid int) (*Response, error) {
+	return s.DeleteVideoWithContext(context.Background(), gr, vid)
+}
+
+// DeleteVideoWithContext method is the same as DeleteVideo, with the addition of the ability to pass a context.
+func (s *GroupsService) DeleteVideoWithContext(ctx context.Context, gr string, vid int) (*Response, error) {
 	u := fmt.Sprintf("groups/%s/videos/%d", gr, vid)
-	resp, err := deleteVideo(s.client, u)
+	resp, err := deleteVideo(ctx, s.client, u)
 
 	return resp, err
 }
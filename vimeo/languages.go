@@ -1,5 +1,7 @@
 package vimeo
 
+import "context"
+
 // LanguagesService handles communication with the languages related
 // methods of the Vimeo API.
 //
@@ -21,12 +23,17 @@ type Language struct {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_languages
 func (s *LanguagesService) List(opt ...CallOption) ([]*Language, *Response, error) {
+	return s.ListWithContext(context.Background(), opt...)
+}
+
+// ListWithContext method is the same as List, with the addition of the ability to pass a context.
+func (s *LanguagesService) ListWithContext(ctx context.Context, opt ...CallOption) ([]*Language, *Response, error) {
 	u, err := addOptions("languages", opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
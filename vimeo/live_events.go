@@ -0,0 +1,335 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LiveEventsService handles communication with the live event related
+// methods of the Vimeo API. A live event is a recurring stream, each
+// session of which is archived as a video.
+// Passing the empty string as uid uses the authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live
+type LiveEventsService service
+
+type dataListLiveEvent struct {
+	Data []*LiveEvent `json:"data"`
+	pagination
+}
+
+type dataListLiveSession struct {
+	Data []*LiveSession `json:"data"`
+	pagination
+}
+
+// LiveSchedule internal object provides access to the schedule of a live event.
+// Type is single for a single date, or weekly for the given weekdays at DailyTime.
+type LiveSchedule struct {
+	Type      string    `json:"type,omitempty"`
+	StartTime time.Time `json:"start_time,omitempty"`
+	Weekdays  []string  `json:"weekdays,omitempty"`
+	DailyTime string    `json:"daily_time,omitempty"`
+}
+
+// LiveStream internal object provides access to the ingest settings of a live event,
+// to be set in the streaming software.
+type LiveStream struct {
+	RTMPLink  string `json:"rtmp_link,omitempty"`
+	RTMPSLink string `json:"rtmps_link,omitempty"`
+	StreamKey string `json:"stream_key,omitempty"`
+}
+
+// LiveEvent represents a live event.
+type LiveEvent struct {
+	URI                      string        `json:"uri,omitempty"`
+	Title                    string        `json:"title,omitempty"`
+	Link                     string        `json:"link,omitempty"`
+	CreatedTime              time.Time     `json:"created_time,omitempty"`
+	StreamTitle              string        `json:"stream_title,omitempty"`
+	StreamDescription        string        `json:"stream_description,omitempty"`
+	StreamPrivacy            *Privacy      `json:"stream_privacy,omitempty"`
+	AutomaticallyTitleStream bool          `json:"automatically_title_stream,omitempty"`
+	TimeZone                 string        `json:"time_zone,omitempty"`
+	Schedule                 *LiveSchedule `json:"schedule,omitempty"`
+	Embed                    *Embed        `json:"embed,omitempty"`
+	StreamableVideo          *Video        `json:"streamable_video,omitempty"`
+	Pictures                 *Pictures     `json:"pictures,omitempty"`
+	User                     *User         `json:"user,omitempty"`
+	LiveStream
+}
+
+// LiveEventRequest represents a request to create/edit a live event.
+type LiveEventRequest struct {
+	Title                    string        `json:"title,omitempty"`
+	StreamTitle              string        `json:"stream_title,omitempty"`
+	StreamDescription        string        `json:"stream_description,omitempty"`
+	StreamPrivacy            *Privacy      `json:"stream_privacy,omitempty"`
+	StreamPassword           string        `json:"stream_password,omitempty"`
+	ContentRating            []string      `json:"content_rating,omitempty"`
+	AutomaticallyTitleStream *bool         `json:"automatically_title_stream,omitempty"`
+	TimeZone                 string        `json:"time_zone,omitempty"`
+	Schedule                 *LiveSchedule `json:"schedule,omitempty"`
+	FolderURI                string        `json:"folder_uri,omitempty"`
+}
+
+// LiveSession represents a past or current session of a live event.
+type LiveSession struct {
+	URI         string    `json:"uri,omitempty"`
+	Status      string    `json:"status,omitempty"`
+	StartedTime time.Time `json:"started_time,omitempty"`
+	EndedTime   time.Time `json:"ended_time,omitempty"`
+	Video       *Video    `json:"video,omitempty"`
+}
+
+// GetID returns the numeric identifier (ID) of the live event.
+func (e LiveEvent) GetID() int {
+	l := strings.Split(e.URI, "/")
+	ID, _ := strconv.Atoi(l[len(l)-1])
+	return ID
+}
+
+// liveEventURL returns the URL of the live events of the specified user, followed by path.
+func liveEventURL(uid string, path string) string {
+	if uid == "" {
+		return "me/live_events" + path
+	}
+	return fmt.Sprintf("users/%s/live_events%s", uid, path)
+}
+
+// getLive gets a live events resource, such as an event, its stream or a list, and decodes it into v.
+func getLive(ctx context.Context, c *Client, url string, v interface{}, opt ...CallOption) (*Response, error) {
+	u, err := addOptions(url, opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(req, v)
+}
+
+// List method gets all the live events of the specified user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#get_live_events
+func (s *LiveEventsService) List(uid string, opt ...CallOption) ([]*LiveEvent, *Response, error) {
+	return s.ListWithContext(context.Background(), uid, opt...)
+}
+
+// ListWithContext method is the same as List, with the addition of the ability to pass a context.
+func (s *LiveEventsService) ListWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*LiveEvent, *Response, error) {
+	events := &dataListLiveEvent{}
+
+	resp, err := getLive(ctx, s.client, liveEventURL(uid, ""), events, opt...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(events)
+
+	return events.Data, resp, err
+}
+
+// Create method creates a live event for the specified user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#create_live_event
+func (s *LiveEventsService) Create(uid string, r *LiveEventRequest) (*LiveEvent, *Response, error) {
+	return s.CreateWithContext(context.Background(), uid, r)
+}
+
+// CreateWithContext method is the same as Create, with the addition of the ability to pass a context.
+func (s *LiveEventsService) CreateWithContext(ctx context.Context, uid string, r *LiveEventRequest) (*LiveEvent, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", liveEventURL(uid, ""), r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	event := &LiveEvent{}
+	resp, err := s.client.Do(req, event)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return event, resp, nil
+}
+
+// Get method gets a single live event.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#get_live_event
+func (s *LiveEventsService) Get(uid string, eid int, opt ...CallOption) (*LiveEvent, *Response, error) {
+	return s.GetWithContext(context.Background(), uid, eid, opt...)
+}
+
+// GetWithContext method is the same as Get, with the addition of the ability to pass a context.
+func (s *LiveEventsService) GetWithContext(ctx context.Context, uid string, eid int, opt ...CallOption) (*LiveEvent, *Response, error) {
+	event := &LiveEvent{}
+
+	resp, err := getLive(ctx, s.client, liveEventURL(uid, fmt.Sprintf("/%d", eid)), event, opt...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return event, resp, err
+}
+
+// GetStream method gets the RTMP and RTMPS links and the stream key of a live event.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#get_live_event
+func (s *LiveEventsService) GetStream(uid string, eid int) (*LiveStream, *Response, error) {
+	return s.GetStreamWithContext(context.Background(), uid, eid)
+}
+
+// GetStreamWithContext method is the same as GetStream, with the addition of the ability to pass a context.
+func (s *LiveEventsService) GetStreamWithContext(ctx context.Context, uid string, eid int) (*LiveStream, *Response, error) {
+	stream := &LiveStream{}
+
+	resp, err := getLive(ctx, s.client, liveEventURL(uid, fmt.Sprintf("/%d", eid)), stream, OptFields{"rtmp_link", "rtmps_link", "stream_key"})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stream, resp, err
+}
+
+// GetEmbed method gets the embed code of a live event.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#get_live_event
+func (s *LiveEventsService) GetEmbed(uid string, eid int) (*Embed, *Response, error) {
+	return s.GetEmbedWithContext(context.Background(), uid, eid)
+}
+
+// GetEmbedWithContext method is the same as GetEmbed, with the addition of the ability to pass a context.
+func (s *LiveEventsService) GetEmbedWithContext(ctx context.Context, uid string, eid int) (*Embed, *Response, error) {
+	event, resp, err := s.GetWithContext(ctx, uid, eid, OptFields{"embed"})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if event.Embed == nil {
+		return &Embed{}, resp, nil
+	}
+
+	return event.Embed, resp, nil
+}
+
+// Edit method edits a live event.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#edit_live_event
+func (s *LiveEventsService) Edit(uid string, eid int, r *LiveEventRequest) (*LiveEvent, *Response, error) {
+	return s.EditWithContext(context.Background(), uid, eid, r)
+}
+
+// EditWithContext method is the same as Edit, with the addition of the ability to pass a context.
+func (s *LiveEventsService) EditWithContext(ctx context.Context, uid string, eid int, r *LiveEventRequest) (*LiveEvent, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", liveEventURL(uid, fmt.Sprintf("/%d", eid)), r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	event := &LiveEvent{}
+	resp, err := s.client.Do(req, event)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return event, resp, nil
+}
+
+// Delete method deletes a live event. Its archived videos are kept.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#delete_live_event
+func (s *LiveEventsService) Delete(uid string, eid int) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), uid, eid)
+}
+
+// DeleteWithContext method is the same as Delete, with the addition of the ability to pass a context.
+func (s *LiveEventsService) DeleteWithContext(ctx context.Context, uid string, eid int) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", liveEventURL(uid, fmt.Sprintf("/%d", eid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Activate method starts a new session of a live event, which can then receive the stream.
+// It returns the links and the stream key of the session.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#activate_live_event
+func (s *LiveEventsService) Activate(uid string, eid int) (*LiveStream, *Response, error) {
+	return s.ActivateWithContext(context.Background(), uid, eid)
+}
+
+// ActivateWithContext method is the same as Activate, with the addition of the ability to pass a context.
+func (s *LiveEventsService) ActivateWithContext(ctx context.Context, uid string, eid int) (*LiveStream, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", liveEventURL(uid, fmt.Sprintf("/%d/activate", eid)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream := &LiveStream{}
+	resp, err := s.client.Do(req, stream)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stream, resp, nil
+}
+
+// End method ends the current session of a live event, which is then archived as a video.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#end_live_event
+func (s *LiveEventsService) End(uid string, eid int) (*Response, error) {
+	return s.EndWithContext(context.Background(), uid, eid)
+}
+
+// EndWithContext method is the same as End, with the addition of the ability to pass a context.
+func (s *LiveEventsService) EndWithContext(ctx context.Context, uid string, eid int) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", liveEventURL(uid, fmt.Sprintf("/%d/end", eid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListSession method gets the past and current sessions of a live event.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#get_live_event_sessions
+func (s *LiveEventsService) ListSession(uid string, eid int, opt ...CallOption) ([]*LiveSession, *Response, error) {
+	return s.ListSessionWithContext(context.Background(), uid, eid, opt...)
+}
+
+// ListSessionWithContext method is the same as ListSession, with the addition of the ability to pass a context.
+func (s *LiveEventsService) ListSessionWithContext(ctx context.Context, uid string, eid int, opt ...CallOption) ([]*LiveSession, *Response, error) {
+	sessions := &dataListLiveSession{}
+
+	resp, err := getLive(ctx, s.client, liveEventURL(uid, fmt.Sprintf("/%d/sessions", eid)), sessions, opt...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(sessions)
+
+	return sessions.Data, resp, err
+}
+
+// ListVideo method gets the videos archived from the sessions of a live event.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#get_live_event_videos
+func (s *LiveEventsService) ListVideo(uid string, eid int, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.ListVideoWithContext(context.Background(), uid, eid, opt...)
+}
+
+// ListVideoWithContext method is the same as ListVideo, with the addition of the ability to pass a context.
+func (s *LiveEventsService) ListVideoWithContext(ctx context.Context, uid string, eid int, opt ...CallOption) ([]*Video, *Response, error) {
+	videos, resp, err := listVideo(ctx, s.client, liveEventURL(uid, fmt.Sprintf("/%d/videos", eid)), opt...)
+
+	return videos, resp, err
+}
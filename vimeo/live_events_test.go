@@ -0,0 +1,267 @@
+package vimeo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLiveEvent_GetID(t *testing.T) {
+	v := &LiveEvent{URI: "/live_events/2"}
+
+	if id := v.GetID(); id != 2 {
+		t.Errorf("LiveEvent.GetID returned %+v, want %+v", id, 2)
+	}
+}
+
+func TestLiveEventsService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"title": "Test"}]}`)
+	})
+
+	events, _, err := client.LiveEvents.List("", OptPage(1), OptPerPage(2))
+	if err != nil {
+		t.Errorf("LiveEvents.List returned unexpected error: %v", err)
+	}
+
+	want := []*LiveEvent{{Title: "Test"}}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("LiveEvents.List returned %+v, want %+v", events, want)
+	}
+}
+
+func TestLiveEventsService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &LiveEventRequest{
+		Title:    "Weekly",
+		TimeZone: "Europe/Paris",
+		Schedule: &LiveSchedule{Type: "weekly", Weekdays: []string{"1"}, DailyTime: "18:00:00Z"},
+	}
+
+	mux.HandleFunc("/users/1/live_events", func(w http.ResponseWriter, r *http.Request) {
+		v := &LiveEventRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("LiveEvents.Create returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("LiveEvents.Create body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"uri": "/live_events/2", "title": "Weekly", "stream_key": "key"}`)
+	})
+
+	event, _, err := client.LiveEvents.Create("1", input)
+	if err != nil {
+		t.Errorf("LiveEvents.Create returned unexpected error: %v", err)
+	}
+
+	want := &LiveEvent{URI: "/live_events/2", Title: "Weekly", LiveStream: LiveStream{StreamKey: "key"}}
+	if !reflect.DeepEqual(event, want) {
+		t.Errorf("LiveEvents.Create returned %+v, want %+v", event, want)
+	}
+}
+
+func TestLiveEventsService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"title": "Test"}`)
+	})
+
+	event, _, err := client.LiveEvents.Get("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.Get returned unexpected error: %v", err)
+	}
+
+	want := &LiveEvent{Title: "Test"}
+	if !reflect.DeepEqual(event, want) {
+		t.Errorf("LiveEvents.Get returned %+v, want %+v", event, want)
+	}
+}
+
+func TestLiveEventsService_GetStream(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"fields": "rtmp_link,rtmps_link,stream_key",
+		})
+		fmt.Fprint(w, `{"rtmp_link": "rtmp://rtmp.cloud.vimeo.com/live", "rtmps_link": "rtmps://rtmp-global.cloud.vimeo.com:443/live", "stream_key": "key"}`)
+	})
+
+	stream, _, err := client.LiveEvents.GetStream("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.GetStream returned unexpected error: %v", err)
+	}
+
+	want := &LiveStream{RTMPLink: "rtmp://rtmp.cloud.vimeo.com/live", RTMPSLink: "rtmps://rtmp-global.cloud.vimeo.com:443/live", StreamKey: "key"}
+	if !reflect.DeepEqual(stream, want) {
+		t.Errorf("LiveEvents.GetStream returned %+v, want %+v", stream, want)
+	}
+}
+
+func TestLiveEventsService_GetEmbed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"fields": "embed",
+		})
+		fmt.Fprint(w, `{"embed": {"html": "<iframe></iframe>"}}`)
+	})
+
+	embed, _, err := client.LiveEvents.GetEmbed("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.GetEmbed returned unexpected error: %v", err)
+	}
+
+	want := &Embed{HTML: "<iframe></iframe>"}
+	if !reflect.DeepEqual(embed, want) {
+		t.Errorf("LiveEvents.GetEmbed returned %+v, want %+v", embed, want)
+	}
+}
+
+func TestLiveEventsService_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &LiveEventRequest{
+		Title:                    "Test",
+		AutomaticallyTitleStream: Bool(false),
+	}
+
+	mux.HandleFunc("/me/live_events/2", func(w http.ResponseWriter, r *http.Request) {
+		v := &LiveEventRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("LiveEvents.Edit returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("LiveEvents.Edit body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"title": "Test"}`)
+	})
+
+	event, _, err := client.LiveEvents.Edit("", 2, input)
+	if err != nil {
+		t.Errorf("LiveEvents.Edit returned unexpected error: %v", err)
+	}
+
+	want := &LiveEvent{Title: "Test"}
+	if !reflect.DeepEqual(event, want) {
+		t.Errorf("LiveEvents.Edit returned %+v, want %+v", event, want)
+	}
+}
+
+func TestLiveEventsService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.LiveEvents.Delete("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.Delete returned unexpected error: %v", err)
+	}
+}
+
+func TestLiveEventsService_Activate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2/activate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"rtmp_link": "rtmp://rtmp.cloud.vimeo.com/live", "stream_key": "key"}`)
+	})
+
+	stream, _, err := client.LiveEvents.Activate("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.Activate returned unexpected error: %v", err)
+	}
+
+	want := &LiveStream{RTMPLink: "rtmp://rtmp.cloud.vimeo.com/live", StreamKey: "key"}
+	if !reflect.DeepEqual(stream, want) {
+		t.Errorf("LiveEvents.Activate returned %+v, want %+v", stream, want)
+	}
+}
+
+func TestLiveEventsService_End(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2/end", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+	})
+
+	_, err := client.LiveEvents.End("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.End returned unexpected error: %v", err)
+	}
+}
+
+func TestLiveEventsService_ListSession(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2/sessions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"status": "done", "started_time": "2024-01-01T18:00:00Z", "video": {"uri": "/videos/1"}}]}`)
+	})
+
+	sessions, _, err := client.LiveEvents.ListSession("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.ListSession returned unexpected error: %v", err)
+	}
+
+	want := []*LiveSession{{Status: "done", StartedTime: time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC), Video: &Video{URI: "/videos/1"}}}
+	if !reflect.DeepEqual(sessions, want) {
+		t.Errorf("LiveEvents.ListSession returned %+v, want %+v", sessions, want)
+	}
+}
+
+func TestLiveEventsService_ListVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	videos, _, err := client.LiveEvents.ListVideo("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.ListVideo returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("LiveEvents.ListVideo returned %+v, want %+v", videos, want)
+	}
+}
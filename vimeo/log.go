@@ -0,0 +1,243 @@
+package vimeo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	headerRequestID = "X-Request-Id"
+
+	redacted = "REDACTED"
+
+	// maxLoggedBody is the maximum number of body bytes added to a log record.
+	maxLoggedBody = 4096
+)
+
+// Redaction is the policy hiding secrets from log records and error messages.
+// Names are matched case-insensitively. The Authorization header, holding the
+// bearer token, is never logged, and URLs outside the API, such as upload links,
+// are logged without their path and query.
+type Redaction struct {
+	// QueryParams lists the query parameters whose value is hidden.
+	QueryParams []string
+
+	// BodyFields lists the JSON fields whose value is hidden, at any depth of the body.
+	BodyFields []string
+}
+
+// DefaultRedaction returns the redaction policy used when Config.Redaction is nil.
+func DefaultRedaction() *Redaction {
+	return &Redaction{
+		QueryParams: []string{"client_secret", "access_token", "token", "password"},
+		BodyFields: []string{
+			"password", "client_secret", "access_token", "refresh_token", "token",
+			"upload_link", "complete_uri", "link",
+			"stream_key", "stream_password", "rtmp_link", "rtmps_link",
+		},
+	}
+}
+
+func (r *Redaction) queryParam(name string) bool {
+	return containsFold(r.QueryParams, name)
+}
+
+func (r *Redaction) bodyField(name string) bool {
+	return containsFold(r.BodyFields, name)
+}
+
+// query returns a copy of the query with the secret values hidden.
+func (r *Redaction) query(q url.Values) url.Values {
+	out := make(url.Values, len(q))
+	for k, v := range q {
+		if r.queryParam(k) {
+			v = []string{redacted}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// body returns a JSON document with the secret fields hidden.
+// Documents which are not valid JSON are not logged.
+func (r *Redaction) body(data []byte) string {
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return "[unparsed body]"
+	}
+
+	out, err := json.Marshal(r.value(doc))
+	if err != nil {
+		return "[unparsed body]"
+	}
+
+	return string(out)
+}
+
+func (r *Redaction) value(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if r.bodyField(k) && item != nil {
+				v[k] = redacted
+			} else {
+				v[k] = r.value(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = r.value(item)
+		}
+	}
+	return v
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) logger() *slog.Logger {
+	if c.Config == nil {
+		return nil
+	}
+	return c.Config.Logger
+}
+
+func (c *Client) redaction() *Redaction {
+	if c.Config != nil && c.Config.Redaction != nil {
+		return c.Config.Redaction
+	}
+	return DefaultRedaction()
+}
+
+// redactURL returns the URL as logged: API URLs with their secret query
+// parameters hidden, and other URLs, such as upload links, reduced to their host.
+func (c *Client) redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	if u.Host != c.BaseURL.Host {
+		return u.Scheme + "://" + u.Host + "/" + redacted
+	}
+
+	clean := *u
+	clean.RawQuery = c.redaction().query(u.Query()).Encode()
+	return clean.String()
+}
+
+// redactError returns the message of the error as logged, with the given URLs
+// redacted, as transport errors quote the URL of the request.
+func (c *Client) redactError(err error, urls ...string) string {
+	msg := err.Error()
+	for _, raw := range urls {
+		u, perr := url.Parse(raw)
+		if raw == "" || perr != nil {
+			continue
+		}
+		msg = strings.ReplaceAll(msg, raw, c.redactURL(u))
+	}
+	return msg
+}
+
+// log writes a debug record to the logger of the client, if any.
+func (c *Client) log(ctx context.Context, msg string, attrs ...slog.Attr) {
+	if logger := c.logger(); logger != nil {
+		logger.LogAttrs(ctx, slog.LevelDebug, msg, attrs...)
+	}
+}
+
+// logCall writes the record of an API call.
+func (c *Client) logCall(req *http.Request, resp *Response, err error, latency time.Duration, body *bodyRecorder) {
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", c.redactURL(req.URL)),
+		slog.Duration("latency", latency),
+	}
+
+	if resp != nil {
+		attrs = append(attrs,
+			slog.Int("status", resp.StatusCode),
+			slog.Int("attempts", resp.Attempts),
+		)
+
+		if id := resp.Header.Get(headerRequestID); id != "" {
+			attrs = append(attrs, slog.String("request_id", id))
+		}
+
+		if resp.Rate.Limit > 0 {
+			attrs = append(attrs, slog.Group("rate",
+				slog.Int("limit", resp.Rate.Limit),
+				slog.Int("remaining", resp.Rate.Remaining),
+				slog.Time("reset", resp.Rate.Reset),
+			))
+		}
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", c.redactError(err, req.URL.String())))
+	}
+
+	if c.Config.LogBodies {
+		if data := requestBody(req); len(data) > 0 {
+			attrs = append(attrs, slog.String("request_body", c.redaction().body(data)))
+		}
+		if body != nil && body.buf.Len() > 0 && isJSON(body.contentType) {
+			attrs = append(attrs, slog.String("response_body", c.redaction().body(body.buf.Bytes())))
+		}
+	}
+
+	c.log(req.Context(), "vimeo: api call", attrs...)
+}
+
+// requestBody returns the JSON body of the request, if it can be read again.
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil || !isJSON(req.Header.Get("Content-Type")) {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	data, _ := io.ReadAll(io.LimitReader(body, maxLoggedBody))
+	return data
+}
+
+func isJSON(contentType string) bool {
+	t, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (t == "application/json" || strings.HasSuffix(t, "+json"))
+}
+
+// bodyRecorder keeps the beginning of a response body as it is read.
+type bodyRecorder struct {
+	io.ReadCloser
+	contentType string
+	buf         bytes.Buffer
+}
+
+func (b *bodyRecorder) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if room := maxLoggedBody - b.buf.Len(); room > 0 {
+		if room > n {
+			room = n
+		}
+		b.buf.Write(p[:room])
+	}
+	return n, err
+}
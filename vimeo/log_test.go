@@ -0,0 +1,162 @@
+package vimeo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestDo_logger(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	client.Config.Logger = slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client.Config.LogBodies = true
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(headerRequestID, "abc")
+		w.Header().Set(headerRateLimit, "100")
+		w.Header().Set(headerRateRemaining, "99")
+		fmt.Fprint(w, `{"name": "name", "upload": {"upload_link": "https://files.tus.vimeo.com/secret"}}`)
+	})
+
+	_, _, err := client.Videos.Edit(1, &VideoRequest{Name: "name", Password: "s3cr3t"})
+	if err != nil {
+		t.Fatalf("Videos.Edit returned unexpected error: %v", err)
+	}
+
+	record := map[string]interface{}{}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("Log record %q is not JSON: %v", buf.String(), err)
+	}
+
+	for k, want := range map[string]interface{}{
+		"msg":        "vimeo: api call",
+		"method":     "PATCH",
+		"status":     float64(200),
+		"request_id": "abc",
+	} {
+		if record[k] != want {
+			t.Errorf("Log record %v is %v, want %v", k, record[k], want)
+		}
+	}
+
+	if rate, ok := record["rate"].(map[string]interface{}); !ok || rate["remaining"] != float64(99) {
+		t.Errorf("Log record rate is %v", record["rate"])
+	}
+
+	if log := buf.String(); strings.Contains(log, "s3cr3t") || strings.Contains(log, "files.tus.vimeo.com/secret") {
+		t.Errorf("Log record leaks secrets: %v", log)
+	}
+
+	if body, _ := record["request_body"].(string); !strings.Contains(body, `"password":"REDACTED"`) {
+		t.Errorf("Log record request_body is %v", body)
+	}
+}
+
+func TestDo_loggerErrorURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	client.Config.Logger = slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	files := httptest.NewServer(http.NotFoundHandler())
+	defer files.Close()
+
+	req, _ := http.NewRequest("HEAD", files.URL+"/files/secret-upload-id", nil)
+	_, err := client.Do(req, nil)
+	if err == nil {
+		t.Fatal("Expected error to be returned.")
+	}
+
+	if strings.Contains(err.Error(), "secret-upload-id") {
+		t.Errorf("Error leaks the upload link: %v", err)
+	}
+	if log := buf.String(); !strings.Contains(log, "404") || strings.Contains(log, "secret-upload-id") {
+		t.Errorf("Log record leaks the upload link: %v", log)
+	}
+}
+
+func TestDo_errorRedaction(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.Redaction = &Redaction{QueryParams: []string{"signature"}}
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "bad"}`, http.StatusBadRequest)
+	})
+
+	req, _ := client.NewRequest("GET", "videos/1?signature=s3cr3t", nil)
+	_, err := client.Do(req, nil)
+	if err == nil {
+		t.Fatal("Expected error to be returned.")
+	}
+
+	if msg := err.Error(); strings.Contains(msg, "s3cr3t") || !strings.Contains(msg, "signature=REDACTED") {
+		t.Errorf("Error does not follow Config.Redaction: %v", msg)
+	}
+}
+
+func TestDo_loggerDisabled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	client.Config.Logger = slog.New(slog.NewJSONHandler(&buf, nil))
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name": "name"}`)
+	})
+
+	if _, _, err := client.Videos.Get(1); err != nil {
+		t.Fatalf("Videos.Get returned unexpected error: %v", err)
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("Logger below debug level received %v", buf.String())
+	}
+}
+
+func TestClient_redactURL(t *testing.T) {
+	c := NewClient(nil, nil)
+
+	api, _ := url.Parse(defaultBaseURL + "videos?access_token=TOKEN&page=2")
+	if got, want := c.redactURL(api), defaultBaseURL+"videos?access_token=REDACTED&page=2"; got != want {
+		t.Errorf("redactURL is %v, want %v", got, want)
+	}
+
+	upload, _ := url.Parse("https://files.tus.vimeo.com/files/vimeo-prod-src-tus-us/secret")
+	if got, want := c.redactURL(upload), "https://files.tus.vimeo.com/REDACTED"; got != want {
+		t.Errorf("redactURL is %v, want %v", got, want)
+	}
+}
+
+func TestRedaction_body(t *testing.T) {
+	r := DefaultRedaction()
+
+	got := r.body([]byte(`{"name": "a", "privacy": {"view": "password"}, "password": "p", "data": [{"token": "t"}]}`))
+	want := `{"data":[{"token":"REDACTED"}],"name":"a","password":"REDACTED","privacy":{"view":"password"}}`
+	if got != want {
+		t.Errorf("Redaction.body is %v, want %v", got, want)
+	}
+}
+
+func TestRedaction_bodyLiveStream(t *testing.T) {
+	r := DefaultRedaction()
+
+	got := r.body([]byte(`{"title": "a", "stream_password": "hunter2", "stream_key": "SK-123", "rtmp_link": "rtmp://a", "rtmps_link": "rtmps://a"}`))
+	want := `{"rtmp_link":"REDACTED","rtmps_link":"REDACTED","stream_key":"REDACTED","stream_password":"REDACTED","title":"a"}`
+	if got != want {
+		t.Errorf("Redaction.body is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,15 @@
+package vimeo
+
+import "net/http"
+
+// Handler sends an API request and returns the API response, as Client.Do does.
+// v receives the decoded response body.
+type Handler func(req *http.Request, v interface{}) (*Response, error)
+
+// Middleware wraps a Handler to run code around every API call: it may change
+// the request before calling next, inspect the Response and the decoded value
+// after it, or handle the returned *ErrorResponse and *RateLimitError.
+//
+// Middlewares are configured with Config.Middleware. The first one is the outermost,
+// and the retries of Config.Retry happen inside the chain.
+type Middleware func(next Handler) Handler
@@ -0,0 +1,87 @@
+package vimeo
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDo_middleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(req *http.Request, v interface{}) (*Response, error) {
+				calls = append(calls, name+" before")
+				req.Header.Add("X-Trace", name)
+				resp, err := next(req, v)
+				calls = append(calls, name+" after")
+				return resp, err
+			}
+		}
+	}
+	client.Config.Middleware = []Middleware{trace("first"), trace("second")}
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header["X-Trace"], []string{"first", "second"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Request X-Trace header is %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	video, _, err := client.Videos.Get(1)
+	if err != nil {
+		t.Fatalf("Videos.Get returned unexpected error: %v", err)
+	}
+
+	if video.Name != "Test" {
+		t.Errorf("Videos.Get returned %+v", video)
+	}
+
+	want := []string{"first before", "second before", "second after", "first after"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("Middleware calls are %v, want %v", calls, want)
+	}
+}
+
+func TestDo_middlewareSeesError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	token := "expired"
+	refresh := func(next Handler) Handler {
+		return func(req *http.Request, v interface{}) (*Response, error) {
+			req.Header.Set("Authorization", "bearer "+token)
+			resp, err := next(req, v)
+			if !IsUnauthorized(err) {
+				return resp, err
+			}
+
+			token = "fresh"
+			req.Header.Set("Authorization", "bearer "+token)
+			return next(req, v)
+		}
+	}
+	client.Config.Middleware = []Middleware{refresh}
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": "A valid user token must be passed."}`)
+			return
+		}
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	video, _, err := client.Videos.Get(1)
+	if err != nil {
+		t.Fatalf("Videos.Get returned unexpected error: %v", err)
+	}
+
+	if video.Name != "Test" {
+		t.Errorf("Videos.Get returned %+v", video)
+	}
+}
@@ -0,0 +1,363 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// OnDemandService handles communication with the On Demand related
+// methods of the Vimeo API. An On Demand page sells a film, or the
+// episodes of a series grouped in seasons.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand
+type OnDemandService service
+
+type dataListOnDemandPage struct {
+	Data []*OnDemandPage `json:"data"`
+	pagination
+}
+
+type dataListOnDemandGenre struct {
+	Data []*OnDemandGenre `json:"data"`
+	pagination
+}
+
+// Types of On Demand pages.
+const (
+	OnDemandFilm   = "film"
+	OnDemandSeries = "series"
+)
+
+// OnDemandPricing internal object provides access to the rent or buy settings of an On Demand page or video.
+// Price maps currency codes, such as USD or EUR, to the price in that currency.
+// Period is the duration of a rental, such as 24 hour, 1 week or 1 month.
+type OnDemandPricing struct {
+	Active bool               `json:"active"`
+	Period string             `json:"period,omitempty"`
+	Price  map[string]float64 `json:"price,omitempty"`
+}
+
+// OnDemandPublished internal object provides access to the publication of an On Demand page.
+type OnDemandPublished struct {
+	Enabled bool      `json:"enabled"`
+	Time    time.Time `json:"time,omitempty"`
+}
+
+// OnDemandPublish represents a request to publish or unpublish an On Demand page.
+type OnDemandPublish struct {
+	Active bool `json:"active"`
+}
+
+// OnDemandGenre represents a genre of On Demand pages.
+type OnDemandGenre struct {
+	URI       string `json:"uri,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Canonical string `json:"canonical,omitempty"`
+	Link      string `json:"link,omitempty"`
+}
+
+// OnDemandPage represents an On Demand page.
+type OnDemandPage struct {
+	URI           string             `json:"uri,omitempty"`
+	Name          string             `json:"name,omitempty"`
+	Type          string             `json:"type,omitempty"`
+	Description   string             `json:"description,omitempty"`
+	Link          string             `json:"link,omitempty"`
+	ContentRating []string           `json:"content_rating,omitempty"`
+	CreatedTime   time.Time          `json:"created_time,omitempty"`
+	ModifiedTime  time.Time          `json:"modified_time,omitempty"`
+	ReleaseYear   int                `json:"release_year,omitempty"`
+	Published     *OnDemandPublished `json:"published,omitempty"`
+	Rent          *OnDemandPricing   `json:"rent,omitempty"`
+	Buy           *OnDemandPricing   `json:"buy,omitempty"`
+	Genres        []*OnDemandGenre   `json:"genres,omitempty"`
+	Film          *Video             `json:"film,omitempty"`
+	Trailer       *Video             `json:"trailer,omitempty"`
+	Pictures      *Pictures          `json:"pictures,omitempty"`
+	Background    *Pictures          `json:"background,omitempty"`
+	User          *User              `json:"user,omitempty"`
+	ResourceKey   string             `json:"resource_key,omitempty"`
+}
+
+// OnDemandPageRequest represents a request to create/edit an On Demand page.
+// Link is the custom name of the page in its URL.
+type OnDemandPageRequest struct {
+	Name          string           `json:"name,omitempty"`
+	Type          string           `json:"type,omitempty"`
+	Description   string           `json:"description,omitempty"`
+	Link          string           `json:"link,omitempty"`
+	ContentRating []string         `json:"content_rating,omitempty"`
+	ReleaseYear   int              `json:"release_year,omitempty"`
+	Publish       *OnDemandPublish `json:"publish,omitempty"`
+	Rent          *OnDemandPricing `json:"rent,omitempty"`
+	Buy           *OnDemandPricing `json:"buy,omitempty"`
+}
+
+// GetID returns the identifier (ID) of the On Demand page.
+func (p OnDemandPage) GetID() string {
+	l := strings.Split(p.URI, "/")
+	return l[len(l)-1]
+}
+
+// GetID returns the identifier (ID) of the genre.
+func (g OnDemandGenre) GetID() string {
+	l := strings.Split(g.URI, "/")
+	return l[len(l)-1]
+}
+
+func listOnDemandPage(ctx context.Context, c *Client, url string, opt ...CallOption) ([]*OnDemandPage, *Response, error) {
+	u, err := addOptions(url, opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pages := &dataListOnDemandPage{}
+
+	resp, err := c.Do(req, pages)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(pages)
+
+	return pages.Data, resp, err
+}
+
+func listOnDemandGenre(ctx context.Context, c *Client, url string, opt ...CallOption) ([]*OnDemandGenre, *Response, error) {
+	u, err := addOptions(url, opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	genres := &dataListOnDemandGenre{}
+
+	resp, err := c.Do(req, genres)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(genres)
+
+	return genres.Data, resp, err
+}
+
+// List method gets the On Demand pages of the specified user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-essentials#get_user_vods
+func (s *OnDemandService) List(uid string, opt ...CallOption) ([]*OnDemandPage, *Response, error) {
+	return s.ListWithContext(context.Background(), uid, opt...)
+}
+
+// ListWithContext method is the same as List, with the addition of the ability to pass a context.
+func (s *OnDemandService) ListWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*OnDemandPage, *Response, error) {
+	var u string
+	if uid == "" {
+		u = "me/ondemand/pages"
+	} else {
+		u = fmt.Sprintf("users/%s/ondemand/pages", uid)
+	}
+	pages, resp, err := listOnDemandPage(ctx, s.client, u, opt...)
+
+	return pages, resp, err
+}
+
+// Create method creates an On Demand page for the specified user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-essentials#create_vod
+func (s *OnDemandService) Create(uid string, r *OnDemandPageRequest) (*OnDemandPage, *Response, error) {
+	return s.CreateWithContext(context.Background(), uid, r)
+}
+
+// CreateWithContext method is the same as Create, with the addition of the ability to pass a context.
+func (s *OnDemandService) CreateWithContext(ctx context.Context, uid string, r *OnDemandPageRequest) (*OnDemandPage, *Response, error) {
+	var u string
+	if uid == "" {
+		u = "me/ondemand/pages"
+	} else {
+		u = fmt.Sprintf("users/%s/ondemand/pages", uid)
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := &OnDemandPage{}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return page, resp, nil
+}
+
+// Get method gets a single On Demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-essentials#get_vod
+func (s *OnDemandService) Get(oid string, opt ...CallOption) (*OnDemandPage, *Response, error) {
+	return s.GetWithContext(context.Background(), oid, opt...)
+}
+
+// GetWithContext method is the same as Get, with the addition of the ability to pass a context.
+func (s *OnDemandService) GetWithContext(ctx context.Context, oid string, opt ...CallOption) (*OnDemandPage, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("ondemand/pages/%s", oid), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := &OnDemandPage{}
+
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return page, resp, err
+}
+
+// Edit method edits an On Demand page, for example to change its prices or publish it.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-essentials#edit_vod
+func (s *OnDemandService) Edit(oid string, r *OnDemandPageRequest) (*OnDemandPage, *Response, error) {
+	return s.EditWithContext(context.Background(), oid, r)
+}
+
+// EditWithContext method is the same as Edit, with the addition of the ability to pass a context.
+func (s *OnDemandService) EditWithContext(ctx context.Context, oid string, r *OnDemandPageRequest) (*OnDemandPage, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s", oid)
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := &OnDemandPage{}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return page, resp, nil
+}
+
+// Delete method deletes a draft On Demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-essentials#delete_vod_draft
+func (s *OnDemandService) Delete(oid string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), oid)
+}
+
+// DeleteWithContext method is the same as Delete, with the addition of the ability to pass a context.
+func (s *OnDemandService) DeleteWithContext(ctx context.Context, oid string) (*Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s", oid)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListGenre method gets all the On Demand genres.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-genres#get_vod_genres
+func (s *OnDemandService) ListGenre(opt ...CallOption) ([]*OnDemandGenre, *Response, error) {
+	return s.ListGenreWithContext(context.Background(), opt...)
+}
+
+// ListGenreWithContext method is the same as ListGenre, with the addition of the ability to pass a context.
+func (s *OnDemandService) ListGenreWithContext(ctx context.Context, opt ...CallOption) ([]*OnDemandGenre, *Response, error) {
+	genres, resp, err := listOnDemandGenre(ctx, s.client, "ondemand/genres", opt...)
+
+	return genres, resp, err
+}
+
+// ListPageGenre method gets the genres of an On Demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-genres#get_vod_genres_by_ondemand_id
+func (s *OnDemandService) ListPageGenre(oid string, opt ...CallOption) ([]*OnDemandGenre, *Response, error) {
+	return s.ListPageGenreWithContext(context.Background(), oid, opt...)
+}
+
+// ListPageGenreWithContext method is the same as ListPageGenre, with the addition of the ability to pass a context.
+func (s *OnDemandService) ListPageGenreWithContext(ctx context.Context, oid string, opt ...CallOption) ([]*OnDemandGenre, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/genres", oid)
+	genres, resp, err := listOnDemandGenre(ctx, s.client, u, opt...)
+
+	return genres, resp, err
+}
+
+// AddGenre method adds a genre to an On Demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-genres#add_vod_genre
+func (s *OnDemandService) AddGenre(oid string, genre string) (*Response, error) {
+	return s.AddGenreWithContext(context.Background(), oid, genre)
+}
+
+// AddGenreWithContext method is the same as AddGenre, with the addition of the ability to pass a context.
+func (s *OnDemandService) AddGenreWithContext(ctx context.Context, oid string, genre string) (*Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/genres/%s", oid, genre)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// RemoveGenre method removes a genre from an On Demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-genres#delete_vod_genre
+func (s *OnDemandService) RemoveGenre(oid string, genre string) (*Response, error) {
+	return s.RemoveGenreWithContext(context.Background(), oid, genre)
+}
+
+// RemoveGenreWithContext method is the same as RemoveGenre, with the addition of the ability to pass a context.
+func (s *OnDemandService) RemoveGenreWithContext(ctx context.Context, oid string, genre string) (*Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/genres/%s", oid, genre)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListPurchase method gets the On Demand pages purchased or rented by the specified user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/users#get_vod_purchases
+func (s *OnDemandService) ListPurchase(uid string, opt ...CallOption) ([]*OnDemandPage, *Response, error) {
+	return s.ListPurchaseWithContext(context.Background(), uid, opt...)
+}
+
+// ListPurchaseWithContext method is the same as ListPurchase, with the addition of the ability to pass a context.
+func (s *OnDemandService) ListPurchaseWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*OnDemandPage, *Response, error) {
+	var u string
+	if uid == "" {
+		u = "me/ondemand/purchases"
+	} else {
+		u = fmt.Sprintf("users/%s/ondemand/purchases", uid)
+	}
+	pages, resp, err := listOnDemandPage(ctx, s.client, u, opt...)
+
+	return pages, resp, err
+}
@@ -0,0 +1,198 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type dataListOnDemandPromotion struct {
+	Data []*OnDemandPromotion `json:"data"`
+	pagination
+}
+
+type dataListOnDemandPromotionCode struct {
+	Data []*OnDemandPromotionCode `json:"data"`
+	pagination
+}
+
+// OnDemandPromotion represents a promotion of an On Demand page.
+// Type is single for one code used many times, batch for many codes used once,
+// or vip for free access. DiscountType is percent, dollars or free, and AccessType is rent or buy.
+type OnDemandPromotion struct {
+	URI          string    `json:"uri,omitempty"`
+	Type         string    `json:"type,omitempty"`
+	DiscountType string    `json:"discount_type,omitempty"`
+	AccessType   string    `json:"access_type,omitempty"`
+	PercentOff   int       `json:"percent_off,omitempty"`
+	DollarsOff   float64   `json:"dollars_off,omitempty"`
+	Total        int       `json:"total,omitempty"`
+	Download     bool      `json:"download,omitempty"`
+	StartTime    time.Time `json:"start_time,omitempty"`
+	EndTime      time.Time `json:"end_time,omitempty"`
+}
+
+// OnDemandPromotionRequest represents a request to create a promotion.
+// Code is the code of a single promotion, Total the number of codes of a batch promotion.
+type OnDemandPromotionRequest struct {
+	Type         string     `json:"type"`
+	DiscountType string     `json:"discount_type"`
+	AccessType   string     `json:"access_type,omitempty"`
+	PercentOff   int        `json:"percent_off,omitempty"`
+	DollarsOff   float64    `json:"dollars_off,omitempty"`
+	Code         string     `json:"code,omitempty"`
+	Total        int        `json:"total,omitempty"`
+	Download     *bool      `json:"download,omitempty"`
+	StartTime    *time.Time `json:"start_time,omitempty"`
+	EndTime      *time.Time `json:"end_time,omitempty"`
+}
+
+// OnDemandPromotionCode represents a code of a promotion.
+type OnDemandPromotionCode struct {
+	Code    string `json:"code,omitempty"`
+	Link    string `json:"link,omitempty"`
+	Uses    int    `json:"uses,omitempty"`
+	MaxUses int    `json:"max_uses,omitempty"`
+}
+
+// GetID returns the numeric identifier (ID) of the promotion.
+func (p OnDemandPromotion) GetID() int {
+	l := strings.Split(p.URI, "/")
+	ID, _ := strconv.Atoi(l[len(l)-1])
+	return ID
+}
+
+// ListPromotion method gets all the promotions of an On Demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-promotions#get_vod_promotions
+func (s *OnDemandService) ListPromotion(oid string, opt ...CallOption) ([]*OnDemandPromotion, *Response, error) {
+	return s.ListPromotionWithContext(context.Background(), oid, opt...)
+}
+
+// ListPromotionWithContext method is the same as ListPromotion, with the addition of the ability to pass a context.
+func (s *OnDemandService) ListPromotionWithContext(ctx context.Context, oid string, opt ...CallOption) ([]*OnDemandPromotion, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("ondemand/pages/%s/promotions", oid), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	promotions := &dataListOnDemandPromotion{}
+
+	resp, err := s.client.Do(req, promotions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(promotions)
+
+	return promotions.Data, resp, err
+}
+
+// CreatePromotion method adds a promotion to an On Demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-promotions#create_vod_promotion
+func (s *OnDemandService) CreatePromotion(oid string, r *OnDemandPromotionRequest) (*OnDemandPromotion, *Response, error) {
+	return s.CreatePromotionWithContext(context.Background(), oid, r)
+}
+
+// CreatePromotionWithContext method is the same as CreatePromotion, with the addition of the ability to pass a context.
+func (s *OnDemandService) CreatePromotionWithContext(ctx context.Context, oid string, r *OnDemandPromotionRequest) (*OnDemandPromotion, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/promotions", oid)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	promotion := &OnDemandPromotion{}
+	resp, err := s.client.Do(req, promotion)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return promotion, resp, nil
+}
+
+// GetPromotion method gets a single promotion of an On Demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-promotions#get_vod_promotion
+func (s *OnDemandService) GetPromotion(oid string, pid int, opt ...CallOption) (*OnDemandPromotion, *Response, error) {
+	return s.GetPromotionWithContext(context.Background(), oid, pid, opt...)
+}
+
+// GetPromotionWithContext method is the same as GetPromotion, with the addition of the ability to pass a context.
+func (s *OnDemandService) GetPromotionWithContext(ctx context.Context, oid string, pid int, opt ...CallOption) (*OnDemandPromotion, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("ondemand/pages/%s/promotions/%d", oid, pid), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	promotion := &OnDemandPromotion{}
+
+	resp, err := s.client.Do(req, promotion)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return promotion, resp, err
+}
+
+// DeletePromotion method deletes a promotion of an On Demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-promotions#delete_vod_promotion
+func (s *OnDemandService) DeletePromotion(oid string, pid int) (*Response, error) {
+	return s.DeletePromotionWithContext(context.Background(), oid, pid)
+}
+
+// DeletePromotionWithContext method is the same as DeletePromotion, with the addition of the ability to pass a context.
+func (s *OnDemandService) DeletePromotionWithContext(ctx context.Context, oid string, pid int) (*Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/promotions/%d", oid, pid)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListPromotionCode method gets the codes of a promotion of an On Demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-promotions#get_vod_promotion_codes
+func (s *OnDemandService) ListPromotionCode(oid string, pid int, opt ...CallOption) ([]*OnDemandPromotionCode, *Response, error) {
+	return s.ListPromotionCodeWithContext(context.Background(), oid, pid, opt...)
+}
+
+// ListPromotionCodeWithContext method is the same as ListPromotionCode, with the addition of the ability to pass a context.
+func (s *OnDemandService) ListPromotionCodeWithContext(ctx context.Context, oid string, pid int, opt ...CallOption) ([]*OnDemandPromotionCode, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("ondemand/pages/%s/promotions/%d/codes", oid, pid), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codes := &dataListOnDemandPromotionCode{}
+
+	resp, err := s.client.Do(req, codes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(codes)
+
+	return codes.Data, resp, err
+}
@@ -0,0 +1,120 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+)
+
+type dataListOnDemandRegion struct {
+	Data []*OnDemandRegion `json:"data"`
+	pagination
+}
+
+// OnDemandRegion represents a country where an On Demand page is sold.
+type OnDemandRegion struct {
+	URI  string `json:"uri,omitempty"`
+	Name string `json:"name,omitempty"`
+	Code string `json:"code,omitempty"`
+}
+
+// ListRegion method gets the countries where an On Demand page is sold.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-regions#get_vod_regions
+func (s *OnDemandService) ListRegion(oid string, opt ...CallOption) ([]*OnDemandRegion, *Response, error) {
+	return s.ListRegionWithContext(context.Background(), oid, opt...)
+}
+
+// ListRegionWithContext method is the same as ListRegion, with the addition of the ability to pass a context.
+func (s *OnDemandService) ListRegionWithContext(ctx context.Context, oid string, opt ...CallOption) ([]*OnDemandRegion, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("ondemand/pages/%s/regions", oid), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	regions := &dataListOnDemandRegion{}
+
+	resp, err := s.client.Do(req, regions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(regions)
+
+	return regions.Data, resp, err
+}
+
+// SetRegions method sells an On Demand page in the given countries only,
+// identified by their ISO 3166-1 alpha-2 codes.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-regions#set_vod_regions
+func (s *OnDemandService) SetRegions(oid string, countries []string) ([]*OnDemandRegion, *Response, error) {
+	return s.SetRegionsWithContext(context.Background(), oid, countries)
+}
+
+// SetRegionsWithContext method is the same as SetRegions, with the addition of the ability to pass a context.
+func (s *OnDemandService) SetRegionsWithContext(ctx context.Context, oid string, countries []string) ([]*OnDemandRegion, *Response, error) {
+	body := struct {
+		Countries []string `json:"countries"`
+	}{countries}
+
+	u := fmt.Sprintf("ondemand/pages/%s/regions", oid)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var regions []*OnDemandRegion
+	resp, err := s.client.Do(req, &regions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return regions, resp, nil
+}
+
+// AddRegion method sells an On Demand page in another country.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-regions#add_vod_region
+func (s *OnDemandService) AddRegion(oid string, country string) (*OnDemandRegion, *Response, error) {
+	return s.AddRegionWithContext(context.Background(), oid, country)
+}
+
+// AddRegionWithContext method is the same as AddRegion, with the addition of the ability to pass a context.
+func (s *OnDemandService) AddRegionWithContext(ctx context.Context, oid string, country string) (*OnDemandRegion, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/regions/%s", oid, country)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	region := &OnDemandRegion{}
+	resp, err := s.client.Do(req, region)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return region, resp, nil
+}
+
+// RemoveRegion method stops selling an On Demand page in a country.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-regions#delete_vod_region
+func (s *OnDemandService) RemoveRegion(oid string, country string) (*Response, error) {
+	return s.RemoveRegionWithContext(context.Background(), oid, country)
+}
+
+// RemoveRegionWithContext method is the same as RemoveRegion, with the addition of the ability to pass a context.
+func (s *OnDemandService) RemoveRegionWithContext(ctx context.Context, oid string, country string) (*Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/regions/%s", oid, country)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,611 @@
+package vimeo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOnDemandPage_GetID(t *testing.T) {
+	v := &OnDemandPage{URI: "/ondemand/pages/film"}
+
+	if id := v.GetID(); id != "film" {
+		t.Errorf("OnDemandPage.GetID returned %+v, want %+v", id, "film")
+	}
+}
+
+func TestOnDemandGenre_GetID(t *testing.T) {
+	v := &OnDemandGenre{URI: "/ondemand/genres/drama"}
+
+	if id := v.GetID(); id != "drama" {
+		t.Errorf("OnDemandGenre.GetID returned %+v, want %+v", id, "drama")
+	}
+}
+
+func TestOnDemandSeason_GetID(t *testing.T) {
+	v := &OnDemandSeason{URI: "/ondemand/pages/series/seasons/3"}
+
+	if id := v.GetID(); id != 3 {
+		t.Errorf("OnDemandSeason.GetID returned %+v, want %+v", id, 3)
+	}
+}
+
+func TestOnDemandPromotion_GetID(t *testing.T) {
+	v := &OnDemandPromotion{URI: "/ondemand/pages/film/promotions/4"}
+
+	if id := v.GetID(); id != 4 {
+		t.Errorf("OnDemandPromotion.GetID returned %+v, want %+v", id, 4)
+	}
+}
+
+func TestOnDemandService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/ondemand/pages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	pages, _, err := client.OnDemand.List("", OptPage(1), OptPerPage(2))
+	if err != nil {
+		t.Errorf("OnDemand.List returned unexpected error: %v", err)
+	}
+
+	want := []*OnDemandPage{{Name: "Test"}}
+	if !reflect.DeepEqual(pages, want) {
+		t.Errorf("OnDemand.List returned %+v, want %+v", pages, want)
+	}
+}
+
+func TestOnDemandService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &OnDemandPageRequest{
+		Name:          "Film",
+		Type:          OnDemandFilm,
+		ContentRating: []string{"safe"},
+		Rent:          &OnDemandPricing{Active: true, Period: "24 hour", Price: map[string]float64{"USD": 2.99}},
+		Buy:           &OnDemandPricing{Active: true, Price: map[string]float64{"USD": 9.99, "EUR": 8.99}},
+	}
+
+	mux.HandleFunc("/users/1/ondemand/pages", func(w http.ResponseWriter, r *http.Request) {
+		v := &OnDemandPageRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("OnDemand.Create returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("OnDemand.Create body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"uri": "/ondemand/pages/film", "name": "Film"}`)
+	})
+
+	page, _, err := client.OnDemand.Create("1", input)
+	if err != nil {
+		t.Errorf("OnDemand.Create returned unexpected error: %v", err)
+	}
+
+	want := &OnDemandPage{URI: "/ondemand/pages/film", Name: "Film"}
+	if !reflect.DeepEqual(page, want) {
+		t.Errorf("OnDemand.Create returned %+v, want %+v", page, want)
+	}
+}
+
+func TestOnDemandService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test", "film": {"name": "Feature"}, "buy": {"active": true, "price": {"USD": 9.99}}}`)
+	})
+
+	page, _, err := client.OnDemand.Get("film")
+	if err != nil {
+		t.Errorf("OnDemand.Get returned unexpected error: %v", err)
+	}
+
+	want := &OnDemandPage{
+		Name: "Test",
+		Film: &Video{Name: "Feature"},
+		Buy:  &OnDemandPricing{Active: true, Price: map[string]float64{"USD": 9.99}},
+	}
+	if !reflect.DeepEqual(page, want) {
+		t.Errorf("OnDemand.Get returned %+v, want %+v", page, want)
+	}
+}
+
+func TestOnDemandService_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &OnDemandPageRequest{
+		Description: "Desc",
+		Publish:     &OnDemandPublish{Active: true},
+	}
+
+	mux.HandleFunc("/ondemand/pages/film", func(w http.ResponseWriter, r *http.Request) {
+		v := &OnDemandPageRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("OnDemand.Edit returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("OnDemand.Edit body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"description": "Desc", "published": {"enabled": true}}`)
+	})
+
+	page, _, err := client.OnDemand.Edit("film", input)
+	if err != nil {
+		t.Errorf("OnDemand.Edit returned unexpected error: %v", err)
+	}
+
+	want := &OnDemandPage{Description: "Desc", Published: &OnDemandPublished{Enabled: true}}
+	if !reflect.DeepEqual(page, want) {
+		t.Errorf("OnDemand.Edit returned %+v, want %+v", page, want)
+	}
+}
+
+func TestOnDemandService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.OnDemand.Delete("film")
+	if err != nil {
+		t.Errorf("OnDemand.Delete returned unexpected error: %v", err)
+	}
+}
+
+func TestOnDemandService_ListGenre(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/genres", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Drama"}]}`)
+	})
+
+	genres, _, err := client.OnDemand.ListGenre()
+	if err != nil {
+		t.Errorf("OnDemand.ListGenre returned unexpected error: %v", err)
+	}
+
+	want := []*OnDemandGenre{{Name: "Drama"}}
+	if !reflect.DeepEqual(genres, want) {
+		t.Errorf("OnDemand.ListGenre returned %+v, want %+v", genres, want)
+	}
+}
+
+func TestOnDemandService_ListPageGenre(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film/genres", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Drama"}]}`)
+	})
+
+	genres, _, err := client.OnDemand.ListPageGenre("film")
+	if err != nil {
+		t.Errorf("OnDemand.ListPageGenre returned unexpected error: %v", err)
+	}
+
+	want := []*OnDemandGenre{{Name: "Drama"}}
+	if !reflect.DeepEqual(genres, want) {
+		t.Errorf("OnDemand.ListPageGenre returned %+v, want %+v", genres, want)
+	}
+}
+
+func TestOnDemandService_AddGenre(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film/genres/drama", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	_, err := client.OnDemand.AddGenre("film", "drama")
+	if err != nil {
+		t.Errorf("OnDemand.AddGenre returned unexpected error: %v", err)
+	}
+}
+
+func TestOnDemandService_RemoveGenre(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film/genres/drama", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.OnDemand.RemoveGenre("film", "drama")
+	if err != nil {
+		t.Errorf("OnDemand.RemoveGenre returned unexpected error: %v", err)
+	}
+}
+
+func TestOnDemandService_ListPurchase(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/ondemand/purchases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	pages, _, err := client.OnDemand.ListPurchase("")
+	if err != nil {
+		t.Errorf("OnDemand.ListPurchase returned unexpected error: %v", err)
+	}
+
+	want := []*OnDemandPage{{Name: "Test"}}
+	if !reflect.DeepEqual(pages, want) {
+		t.Errorf("OnDemand.ListPurchase returned %+v, want %+v", pages, want)
+	}
+}
+
+func TestOnDemandService_ListVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/series/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	videos, _, err := client.OnDemand.ListVideo("series")
+	if err != nil {
+		t.Errorf("OnDemand.ListVideo returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("OnDemand.ListVideo returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestOnDemandService_GetVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/series/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	video, _, err := client.OnDemand.GetVideo("series", 1)
+	if err != nil {
+		t.Errorf("OnDemand.GetVideo returned unexpected error: %v", err)
+	}
+
+	want := &Video{Name: "Test"}
+	if !reflect.DeepEqual(video, want) {
+		t.Errorf("OnDemand.GetVideo returned %+v, want %+v", video, want)
+	}
+}
+
+func TestOnDemandService_AddVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &OnDemandVideoRequest{
+		Type:     OnDemandVideoMain,
+		Position: 2,
+		Buy:      &OnDemandPricing{Active: true, Price: map[string]float64{"USD": 1.99}},
+	}
+
+	mux.HandleFunc("/ondemand/pages/series/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		v := &OnDemandVideoRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("OnDemand.AddVideo returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("OnDemand.AddVideo body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	video, _, err := client.OnDemand.AddVideo("series", 1, input)
+	if err != nil {
+		t.Errorf("OnDemand.AddVideo returned unexpected error: %v", err)
+	}
+
+	want := &Video{Name: "Test"}
+	if !reflect.DeepEqual(video, want) {
+		t.Errorf("OnDemand.AddVideo returned %+v, want %+v", video, want)
+	}
+}
+
+func TestOnDemandService_RemoveVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/series/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.OnDemand.RemoveVideo("series", 1)
+	if err != nil {
+		t.Errorf("OnDemand.RemoveVideo returned unexpected error: %v", err)
+	}
+}
+
+func TestOnDemandService_ListSeason(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/series/seasons", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Season 1", "position": 1}]}`)
+	})
+
+	seasons, _, err := client.OnDemand.ListSeason("series")
+	if err != nil {
+		t.Errorf("OnDemand.ListSeason returned unexpected error: %v", err)
+	}
+
+	want := []*OnDemandSeason{{Name: "Season 1", Position: 1}}
+	if !reflect.DeepEqual(seasons, want) {
+		t.Errorf("OnDemand.ListSeason returned %+v, want %+v", seasons, want)
+	}
+}
+
+func TestOnDemandService_GetSeason(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/series/seasons/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Season 1"}`)
+	})
+
+	season, _, err := client.OnDemand.GetSeason("series", 3)
+	if err != nil {
+		t.Errorf("OnDemand.GetSeason returned unexpected error: %v", err)
+	}
+
+	want := &OnDemandSeason{Name: "Season 1"}
+	if !reflect.DeepEqual(season, want) {
+		t.Errorf("OnDemand.GetSeason returned %+v, want %+v", season, want)
+	}
+}
+
+func TestOnDemandService_ListEpisode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/series/seasons/3/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	videos, _, err := client.OnDemand.ListEpisode("series", 3)
+	if err != nil {
+		t.Errorf("OnDemand.ListEpisode returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("OnDemand.ListEpisode returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestOnDemandService_ListPromotion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film/promotions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"type": "single", "percent_off": 10}]}`)
+	})
+
+	promotions, _, err := client.OnDemand.ListPromotion("film")
+	if err != nil {
+		t.Errorf("OnDemand.ListPromotion returned unexpected error: %v", err)
+	}
+
+	want := []*OnDemandPromotion{{Type: "single", PercentOff: 10}}
+	if !reflect.DeepEqual(promotions, want) {
+		t.Errorf("OnDemand.ListPromotion returned %+v, want %+v", promotions, want)
+	}
+}
+
+func TestOnDemandService_CreatePromotion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &OnDemandPromotionRequest{
+		Type:         "single",
+		DiscountType: "percent",
+		AccessType:   "buy",
+		PercentOff:   10,
+		Code:         "SALE",
+	}
+
+	mux.HandleFunc("/ondemand/pages/film/promotions", func(w http.ResponseWriter, r *http.Request) {
+		v := &OnDemandPromotionRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("OnDemand.CreatePromotion returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("OnDemand.CreatePromotion body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"uri": "/ondemand/pages/film/promotions/4", "type": "single"}`)
+	})
+
+	promotion, _, err := client.OnDemand.CreatePromotion("film", input)
+	if err != nil {
+		t.Errorf("OnDemand.CreatePromotion returned unexpected error: %v", err)
+	}
+
+	want := &OnDemandPromotion{URI: "/ondemand/pages/film/promotions/4", Type: "single"}
+	if !reflect.DeepEqual(promotion, want) {
+		t.Errorf("OnDemand.CreatePromotion returned %+v, want %+v", promotion, want)
+	}
+}
+
+func TestOnDemandService_GetPromotion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film/promotions/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"type": "single"}`)
+	})
+
+	promotion, _, err := client.OnDemand.GetPromotion("film", 4)
+	if err != nil {
+		t.Errorf("OnDemand.GetPromotion returned unexpected error: %v", err)
+	}
+
+	want := &OnDemandPromotion{Type: "single"}
+	if !reflect.DeepEqual(promotion, want) {
+		t.Errorf("OnDemand.GetPromotion returned %+v, want %+v", promotion, want)
+	}
+}
+
+func TestOnDemandService_DeletePromotion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film/promotions/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.OnDemand.DeletePromotion("film", 4)
+	if err != nil {
+		t.Errorf("OnDemand.DeletePromotion returned unexpected error: %v", err)
+	}
+}
+
+func TestOnDemandService_ListPromotionCode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film/promotions/4/codes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"code": "SALE", "max_uses": 100}]}`)
+	})
+
+	codes, _, err := client.OnDemand.ListPromotionCode("film", 4)
+	if err != nil {
+		t.Errorf("OnDemand.ListPromotionCode returned unexpected error: %v", err)
+	}
+
+	want := []*OnDemandPromotionCode{{Code: "SALE", MaxUses: 100}}
+	if !reflect.DeepEqual(codes, want) {
+		t.Errorf("OnDemand.ListPromotionCode returned %+v, want %+v", codes, want)
+	}
+}
+
+func TestOnDemandService_ListRegion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film/regions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "France", "code": "FR"}]}`)
+	})
+
+	regions, _, err := client.OnDemand.ListRegion("film")
+	if err != nil {
+		t.Errorf("OnDemand.ListRegion returned unexpected error: %v", err)
+	}
+
+	want := []*OnDemandRegion{{Name: "France", Code: "FR"}}
+	if !reflect.DeepEqual(regions, want) {
+		t.Errorf("OnDemand.ListRegion returned %+v, want %+v", regions, want)
+	}
+}
+
+func TestOnDemandService_SetRegions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film/regions", func(w http.ResponseWriter, r *http.Request) {
+		v := map[string][]string{}
+		err := json.NewDecoder(r.Body).Decode(&v)
+		if err != nil {
+			t.Fatalf("OnDemand.SetRegions returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "PUT")
+		input := map[string][]string{"countries": {"FR", "DE"}}
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("OnDemand.SetRegions body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `[{"code": "FR"}, {"code": "DE"}]`)
+	})
+
+	regions, _, err := client.OnDemand.SetRegions("film", []string{"FR", "DE"})
+	if err != nil {
+		t.Errorf("OnDemand.SetRegions returned unexpected error: %v", err)
+	}
+
+	want := []*OnDemandRegion{{Code: "FR"}, {Code: "DE"}}
+	if !reflect.DeepEqual(regions, want) {
+		t.Errorf("OnDemand.SetRegions returned %+v, want %+v", regions, want)
+	}
+}
+
+func TestOnDemandService_AddRegion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film/regions/FR", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		fmt.Fprint(w, `{"code": "FR"}`)
+	})
+
+	region, _, err := client.OnDemand.AddRegion("film", "FR")
+	if err != nil {
+		t.Errorf("OnDemand.AddRegion returned unexpected error: %v", err)
+	}
+
+	want := &OnDemandRegion{Code: "FR"}
+	if !reflect.DeepEqual(region, want) {
+		t.Errorf("OnDemand.AddRegion returned %+v, want %+v", region, want)
+	}
+}
+
+func TestOnDemandService_RemoveRegion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/film/regions/FR", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.OnDemand.RemoveRegion("film", "FR")
+	if err != nil {
+		t.Errorf("OnDemand.RemoveRegion returned unexpected error: %v", err)
+	}
+}
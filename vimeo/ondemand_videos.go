@@ -0,0 +1,191 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type dataListOnDemandSeason struct {
+	Data []*OnDemandSeason `json:"data"`
+	pagination
+}
+
+// Types of the videos of an On Demand page.
+const (
+	OnDemandVideoMain    = "main"
+	OnDemandVideoTrailer = "trailer"
+	OnDemandVideoExtra   = "extra"
+)
+
+// OnDemandSeason represents a season of an On Demand series.
+type OnDemandSeason struct {
+	URI         string `json:"uri,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Position    int    `json:"position,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+// OnDemandVideoRequest represents a request to add a video to an On Demand page.
+// The main videos of a series are its episodes, in the order of Position.
+// Rent and Buy set the prices of a single episode.
+type OnDemandVideoRequest struct {
+	Type        string           `json:"type,omitempty"`
+	Position    int              `json:"position,omitempty"`
+	ReleaseYear int              `json:"release_year,omitempty"`
+	Rent        *OnDemandPricing `json:"rent,omitempty"`
+	Buy         *OnDemandPricing `json:"buy,omitempty"`
+}
+
+// GetID returns the numeric identifier (ID) of the season.
+func (s OnDemandSeason) GetID() int {
+	l := strings.Split(s.URI, "/")
+	ID, _ := strconv.Atoi(l[len(l)-1])
+	return ID
+}
+
+// ListVideo method gets all the videos of an On Demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-videos#get_vod_videos
+func (s *OnDemandService) ListVideo(oid string, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.ListVideoWithContext(context.Background(), oid, opt...)
+}
+
+// ListVideoWithContext method is the same as ListVideo, with the addition of the ability to pass a context.
+func (s *OnDemandService) ListVideoWithContext(ctx context.Context, oid string, opt ...CallOption) ([]*Video, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/videos", oid)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
+
+	return videos, resp, err
+}
+
+// GetVideo method gets a single video of an On Demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-videos#get_vod_video
+func (s *OnDemandService) GetVideo(oid string, vid int, opt ...CallOption) (*Video, *Response, error) {
+	return s.GetVideoWithContext(context.Background(), oid, vid, opt...)
+}
+
+// GetVideoWithContext method is the same as GetVideo, with the addition of the ability to pass a context.
+func (s *OnDemandService) GetVideoWithContext(ctx context.Context, oid string, vid int, opt ...CallOption) (*Video, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/videos/%d", oid, vid)
+	video, resp, err := getVideo(ctx, s.client, u, opt...)
+
+	return video, resp, err
+}
+
+// AddVideo method adds a video to an On Demand page, as the film or an episode, a trailer or an extra.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-videos#add_video_to_vod
+func (s *OnDemandService) AddVideo(oid string, vid int, r *OnDemandVideoRequest) (*Video, *Response, error) {
+	return s.AddVideoWithContext(context.Background(), oid, vid, r)
+}
+
+// AddVideoWithContext method is the same as AddVideo, with the addition of the ability to pass a context.
+func (s *OnDemandService) AddVideoWithContext(ctx context.Context, oid string, vid int, r *OnDemandVideoRequest) (*Video, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/videos/%d", oid, vid)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	video := &Video{}
+	resp, err := s.client.Do(req, video)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return video, resp, nil
+}
+
+// RemoveVideo method removes a video from an On Demand page. The video isn't deleted.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-videos#delete_video_from_vod
+func (s *OnDemandService) RemoveVideo(oid string, vid int) (*Response, error) {
+	return s.RemoveVideoWithContext(context.Background(), oid, vid)
+}
+
+// RemoveVideoWithContext method is the same as RemoveVideo, with the addition of the ability to pass a context.
+func (s *OnDemandService) RemoveVideoWithContext(ctx context.Context, oid string, vid int) (*Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/videos/%d", oid, vid)
+	resp, err := deleteVideo(ctx, s.client, u)
+
+	return resp, err
+}
+
+// ListSeason method gets all the seasons of an On Demand series.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-seasons#get_vod_seasons
+func (s *OnDemandService) ListSeason(oid string, opt ...CallOption) ([]*OnDemandSeason, *Response, error) {
+	return s.ListSeasonWithContext(context.Background(), oid, opt...)
+}
+
+// ListSeasonWithContext method is the same as ListSeason, with the addition of the ability to pass a context.
+func (s *OnDemandService) ListSeasonWithContext(ctx context.Context, oid string, opt ...CallOption) ([]*OnDemandSeason, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("ondemand/pages/%s/seasons", oid), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seasons := &dataListOnDemandSeason{}
+
+	resp, err := s.client.Do(req, seasons)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(seasons)
+
+	return seasons.Data, resp, err
+}
+
+// GetSeason method gets a single season of an On Demand series.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-seasons#get_vod_season
+func (s *OnDemandService) GetSeason(oid string, sid int, opt ...CallOption) (*OnDemandSeason, *Response, error) {
+	return s.GetSeasonWithContext(context.Background(), oid, sid, opt...)
+}
+
+// GetSeasonWithContext method is the same as GetSeason, with the addition of the ability to pass a context.
+func (s *OnDemandService) GetSeasonWithContext(ctx context.Context, oid string, sid int, opt ...CallOption) (*OnDemandSeason, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("ondemand/pages/%s/seasons/%d", oid, sid), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	season := &OnDemandSeason{}
+
+	resp, err := s.client.Do(req, season)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return season, resp, err
+}
+
+// ListEpisode method gets the episodes of a season of an On Demand series.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/on-demand-seasons#get_vod_season_videos
+func (s *OnDemandService) ListEpisode(oid string, sid int, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.ListEpisodeWithContext(context.Background(), oid, sid, opt...)
+}
+
+// ListEpisodeWithContext method is the same as ListEpisode, with the addition of the ability to pass a context.
+func (s *OnDemandService) ListEpisodeWithContext(ctx context.Context, oid string, sid int, opt ...CallOption) ([]*Video, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/seasons/%d/videos", oid, sid)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
+
+	return videos, resp, err
+}
@@ -0,0 +1,141 @@
+package vimeo
+
+import (
+	"context"
+	"iter"
+	"net/url"
+)
+
+// ListFunc is a list method accepting a context, such as VideosService.ListWithContext.
+// Methods which take other arguments can be adapted with a closure.
+type ListFunc[T any] func(ctx context.Context, opt ...CallOption) ([]T, *Response, error)
+
+// Pager iterates over every page of a list method by following
+// the next page link of each response until the last page.
+//
+//	pager := vimeo.NewPager(client.Videos.ListWithContext, vimeo.OptPerPage(100))
+//	for pager.Next(ctx) {
+//		for _, video := range pager.Page() {
+//			...
+//		}
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	list ListFunc[T]
+	opt  []CallOption
+
+	page []T
+	resp *Response
+	err  error
+	done bool
+}
+
+// NewPager returns a Pager for the given list method. The options are passed
+// to the first call, subsequent pages reuse the query of the next page link.
+func NewPager[T any](list ListFunc[T], opt ...CallOption) *Pager[T] {
+	return &Pager[T]{list: list, opt: opt}
+}
+
+// Next fetches the next page. It returns false when there are no more pages
+// or an error occurred, in which case Err returns it.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.done {
+		return false
+	}
+
+	page, resp, err := p.list(ctx, p.opt...)
+	p.page, p.resp = page, resp
+	if err != nil {
+		p.err = err
+		p.done = true
+		return false
+	}
+
+	if resp == nil || resp.NextPage == "" {
+		p.done = true
+		return true
+	}
+
+	opt, err := nextPageOptions(resp.NextPage)
+	if err != nil {
+		p.err = err
+		p.done = true
+		return true
+	}
+	p.opt = opt
+
+	return true
+}
+
+// Page returns the items of the current page.
+func (p *Pager[T]) Page() []T {
+	return p.page
+}
+
+// Response returns the response of the current page.
+func (p *Pager[T]) Response() *Response {
+	return p.resp
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All fetches the remaining pages and returns their items.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for p.Next(ctx) {
+		all = append(all, p.page...)
+	}
+
+	return all, p.err
+}
+
+// Items returns an iterator over the items of the remaining pages.
+// An error stops the iteration and is yielded with the zero value of T.
+func (p *Pager[T]) Items(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.Next(ctx) {
+			for _, item := range p.page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+
+		if p.err != nil {
+			var zero T
+			yield(zero, p.err)
+		}
+	}
+}
+
+// queryOption is a CallOption holding a raw query parameter.
+type queryOption struct {
+	key, value string
+}
+
+// Get key/value for make query
+func (o queryOption) Get() (string, string) {
+	return o.key, o.value
+}
+
+// nextPageOptions converts the query of a next page link into call options.
+func nextPageOptions(next string) ([]CallOption, error) {
+	u, err := url.Parse(next)
+	if err != nil {
+		return nil, err
+	}
+
+	var opt []CallOption
+	for k, v := range u.Query() {
+		if len(v) > 0 {
+			opt = append(opt, queryOption{k, v[0]})
+		}
+	}
+
+	return opt, nil
+}
@@ -0,0 +1,123 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func setupPages(t *testing.T) {
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.FormValue("per_page"); got != "1" {
+			t.Errorf("Request per_page is %v, want %v", got, "1")
+		}
+
+		switch r.FormValue("page") {
+		case "", "1":
+			fmt.Fprint(w, `{"total": 2, "page": 1, "paging": {"next": "/videos?page=2&per_page=1"}, "data": [{"name": "Test 1"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total": 2, "page": 2, "paging": {"next": null}, "data": [{"name": "Test 2"}]}`)
+		default:
+			t.Errorf("Unexpected page %v", r.FormValue("page"))
+		}
+	})
+}
+
+func TestPager_Next(t *testing.T) {
+	setup()
+	defer teardown()
+	setupPages(t)
+
+	pager := NewPager(client.Videos.ListWithContext, OptPerPage(1))
+
+	var pages [][]*Video
+	for pager.Next(context.Background()) {
+		pages = append(pages, pager.Page())
+	}
+
+	if err := pager.Err(); err != nil {
+		t.Fatalf("Pager.Err returned unexpected error: %v", err)
+	}
+
+	want := [][]*Video{{{Name: "Test 1"}}, {{Name: "Test 2"}}}
+	if !reflect.DeepEqual(pages, want) {
+		t.Errorf("Pager returned %+v, want %+v", pages, want)
+	}
+
+	if page := pager.Response().Page; page != 2 {
+		t.Errorf("Pager.Response page is %v, want %v", page, 2)
+	}
+}
+
+func TestPager_All(t *testing.T) {
+	setup()
+	defer teardown()
+	setupPages(t)
+
+	list := func(ctx context.Context, opt ...CallOption) ([]*Video, *Response, error) {
+		return client.Videos.ListWithContext(ctx, opt...)
+	}
+
+	videos, err := NewPager(list, OptPerPage(1)).All(context.Background())
+	if err != nil {
+		t.Fatalf("Pager.All returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test 1"}, {Name: "Test 2"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("Pager.All returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestPager_Items(t *testing.T) {
+	setup()
+	defer teardown()
+	setupPages(t)
+
+	var names []string
+	for video, err := range NewPager(client.Videos.ListWithContext, OptPerPage(1)).Items(context.Background()) {
+		if err != nil {
+			t.Fatalf("Pager.Items returned unexpected error: %v", err)
+		}
+		names = append(names, video.Name)
+	}
+
+	want := []string{"Test 1", "Test 2"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Pager.Items returned %+v, want %+v", names, want)
+	}
+}
+
+func TestPager_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("page") == "2" {
+			http.Error(w, `{"error": "Not found"}`, http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"paging": {"next": "/videos?page=2"}, "data": [{"name": "Test 1"}]}`)
+	})
+
+	var names []string
+	var iterErr error
+	for video, err := range NewPager(client.Videos.ListWithContext).Items(context.Background()) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		names = append(names, video.Name)
+	}
+
+	if _, ok := iterErr.(*ErrorResponse); !ok {
+		t.Errorf("Pager.Items error is %#v, want *ErrorResponse", iterErr)
+	}
+
+	if want := []string{"Test 1"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Pager.Items returned %+v, want %+v", names, want)
+	}
+}
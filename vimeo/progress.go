@@ -0,0 +1,99 @@
+package vimeo
+
+import (
+	"io"
+	"time"
+)
+
+// Progress describes the state of an upload. It is reported when the upload
+// starts, from the offset already received by Vimeo, and after every chunk.
+// The chunks of a picture upload are the reads of its body.
+type Progress struct {
+	// BytesSent is the number of bytes received by Vimeo.
+	BytesSent int64
+
+	// Total is the size of the upload.
+	Total int64
+
+	// Throughput is the average number of bytes sent per second since the upload started.
+	Throughput float64
+
+	// ETA is the estimated time until the upload completes, zero while it is unknown.
+	ETA time.Duration
+
+	// Chunk is the number of the last chunk sent, starting at 1. It is 0 when the upload starts.
+	Chunk int
+
+	// ChunkSize is the size of the last chunk sent.
+	ChunkSize int64
+}
+
+// ProgressFunc receives the progress of an upload. It is called synchronously
+// by the upload, so it should return quickly.
+type ProgressFunc func(Progress)
+
+// OptProgress is an optional argument to an upload, reporting its progress.
+// It replaces Config.Progress for this upload.
+type OptProgress ProgressFunc
+
+func (o OptProgress) apply(u *uploadOptions) {
+	u.progress = ProgressFunc(o)
+}
+
+// progressTracker computes the progress reported to a ProgressFunc.
+type progressTracker struct {
+	fn    ProgressFunc
+	total int64
+	start time.Time
+	// initial is the offset at which the upload started.
+	initial int64
+	chunk   int
+}
+
+func newProgressTracker(fn ProgressFunc, total, offset int64) *progressTracker {
+	t := &progressTracker{fn: fn, total: total, start: time.Now(), initial: offset}
+	t.report(offset, 0)
+	return t
+}
+
+// sent reports a chunk of n bytes sent, bringing the upload to offset.
+func (t *progressTracker) sent(offset, n int64) {
+	t.chunk++
+	t.report(offset, n)
+}
+
+func (t *progressTracker) report(offset, n int64) {
+	if t.fn == nil {
+		return
+	}
+
+	p := Progress{
+		BytesSent: offset,
+		Total:     t.total,
+		Chunk:     t.chunk,
+		ChunkSize: n,
+	}
+
+	if elapsed := time.Since(t.start); elapsed > 0 && offset > t.initial {
+		p.Throughput = float64(offset-t.initial) / elapsed.Seconds()
+		p.ETA = time.Duration(float64(t.total-offset) / p.Throughput * float64(time.Second))
+	}
+
+	t.fn(p)
+}
+
+// progressReader reports the bytes read from r as the chunks of an upload starting at offset 0.
+type progressReader struct {
+	r       io.Reader
+	tracker *progressTracker
+	offset  int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.offset += int64(n)
+		r.tracker.sent(r.offset, int64(n))
+	}
+	return n, err
+}
@@ -0,0 +1,166 @@
+package vimeo
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// progressSteps returns the bytes sent and chunk numbers of the reported progress.
+func progressSteps(progress []Progress) [][2]int64 {
+	var steps [][2]int64
+	for _, p := range progress {
+		steps = append(steps, [2]int64{p.BytesSent, int64(p.Chunk)})
+	}
+	return steps
+}
+
+func TestTusUploader_progress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	tus := &tusServer{t: t, size: int64(len(content)), failPatch: 2}
+	mux.Handle("/upload", tus)
+
+	var progress []Progress
+	client.Config.Progress = func(p Progress) {
+		progress = append(progress, p)
+	}
+
+	uploader := &TusUploader{ChunkSize: 40, MaxResumes: 1}
+	err := uploader.UploadFromFile(client, server.URL+"/upload", testFile(t, content))
+	if err != nil {
+		t.Fatalf("TusUploader.UploadFromFile returned unexpected error: %v", err)
+	}
+
+	// The second chunk fails after 20 bytes, the upload resumes at 60.
+	want := [][2]int64{{0, 0}, {40, 1}, {100, 2}}
+	if got := progressSteps(progress); !reflect.DeepEqual(got, want) {
+		t.Errorf("TusUploader reported progress %v, want %v", got, want)
+	}
+
+	for _, p := range progress {
+		if p.Total != int64(len(content)) {
+			t.Errorf("TusUploader reported total %v, want %v", p.Total, len(content))
+		}
+	}
+
+	if last := progress[len(progress)-1]; last.ChunkSize != 40 || last.ETA != 0 || last.Throughput <= 0 {
+		t.Errorf("TusUploader reported final progress %+v", last)
+	}
+}
+
+func TestUsersService_UploadVideo_progress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	mux.Handle("/upload", &tusServer{t: t, size: int64(len(content))})
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"uri": "/videos/1", "upload": {"approach": "tus", "upload_link": "%s/upload"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1"}`)
+	})
+
+	client.Config.Uploader = &TusUploader{ChunkSize: 60}
+	client.Config.Progress = func(p Progress) {
+		t.Errorf("Config.Progress called, want it replaced by OptProgress")
+	}
+
+	var progress []Progress
+	_, _, err := client.Users.UploadVideo("", testFile(t, content), OptProgress(func(p Progress) {
+		progress = append(progress, p)
+	}))
+	if err != nil {
+		t.Fatalf("Users.UploadVideo returned unexpected error: %v", err)
+	}
+
+	want := [][2]int64{{0, 0}, {60, 1}, {100, 2}}
+	if got := progressSteps(progress); !reflect.DeepEqual(got, want) {
+		t.Errorf("Users.UploadVideo reported progress %v, want %v", got, want)
+	}
+}
+
+func TestVideosService_UploadPicture_progress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := []byte("picture")
+
+	mux.HandleFunc("/videos/1/pictures", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"uri": "/videos/1/pictures/2", "link": "%s/picture"}`, server.URL)
+	})
+
+	mux.HandleFunc("/picture", func(w http.ResponseWriter, r *http.Request) {})
+
+	mux.HandleFunc("/videos/1/pictures/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1/pictures/2"}`)
+	})
+
+	var progress []Progress
+	_, _, err := client.Videos.UploadPicture(1, &PicturesRequest{}, testFile(t, content), OptProgress(func(p Progress) {
+		progress = append(progress, p)
+	}))
+	if err != nil {
+		t.Fatalf("Videos.UploadPicture returned unexpected error: %v", err)
+	}
+
+	want := [][2]int64{{0, 0}, {7, 1}}
+	if got := progressSteps(progress); !reflect.DeepEqual(got, want) {
+		t.Errorf("Videos.UploadPicture reported progress %v, want %v", got, want)
+	}
+}
+
+func TestVideosService_UploadPictureFromReader_progress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 200)
+
+	mux.HandleFunc("/videos/1/pictures", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"uri": "/videos/1/pictures/2", "link": "%s/picture"}`, server.URL)
+	})
+
+	mux.HandleFunc("/picture", func(w http.ResponseWriter, r *http.Request) {})
+
+	mux.HandleFunc("/videos/1/pictures/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1/pictures/2"}`)
+	})
+
+	var progress []Progress
+	_, _, err := client.Videos.UploadPictureFromReader(1, &PicturesRequest{}, bytes.NewReader(content), int64(len(content)),
+		OptBandwidth(10<<10),
+		OptProgress(func(p Progress) {
+			progress = append(progress, p)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Videos.UploadPictureFromReader returned unexpected error: %v", err)
+	}
+
+	// The bandwidth limits the reads of the body to 512 bytes.
+	want := [][2]int64{{0, 0}, {512, 1}, {1024, 2}, {1536, 3}, {2000, 4}}
+	if got := progressSteps(progress); !reflect.DeepEqual(got, want) {
+		t.Errorf("Videos.UploadPictureFromReader reported progress %v, want %v", got, want)
+	}
+}
+
+func TestVideosService_UploadPicture_unsupportedOption(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/pictures", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Videos.UploadPictureFromReader created a picture with an unsupported option")
+	})
+
+	_, _, err := client.Videos.UploadPictureFromReader(1, &PicturesRequest{}, bytes.NewReader([]byte("picture")), 7, OptVerify{})
+	if err == nil {
+		t.Error("Videos.UploadPictureFromReader returned no error for OptVerify")
+	}
+}
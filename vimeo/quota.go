@@ -0,0 +1,99 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// QuotaExceededError reports an upload larger than the upload quota of the user.
+// It matches ErrQuotaExceeded with errors.Is.
+type QuotaExceededError struct {
+	// Size is the size of the upload.
+	Size int64
+
+	// Quota is the exceeded quota: space, periodic or filesize.
+	Quota string
+
+	// Available is the number of bytes the quota allows.
+	Available int64
+
+	// ResetDate is the date the periodic quota is renewed, if known.
+	ResetDate time.Time
+}
+
+func (e *QuotaExceededError) Error() string {
+	msg := fmt.Sprintf("vimeo: upload of %d bytes exceeds the %s quota of %d bytes", e.Size, e.Quota, e.Available)
+	if !e.ResetDate.IsZero() {
+		msg += fmt.Sprintf(", reset on %v", e.ResetDate.Format(time.RFC3339))
+	}
+	return msg
+}
+
+// Is reports whether the error matches ErrQuotaExceeded.
+func (e *QuotaExceededError) Is(target error) bool {
+	return target == ErrQuotaExceeded
+}
+
+// Check returns a *QuotaExceededError if an upload of size bytes exceeds the quota.
+func (q *UploadQuota) Check(size int64) error {
+	if q.FileSize != nil && q.FileSize.Max > 0 && size > q.FileSize.Max {
+		return &QuotaExceededError{Size: size, Quota: "filesize", Available: q.FileSize.Max}
+	}
+
+	if q.Periodic != nil && q.Periodic.Max > 0 && size > q.Periodic.Free {
+		return &QuotaExceededError{Size: size, Quota: "periodic", Available: q.Periodic.Free, ResetDate: q.Periodic.ResetDate}
+	}
+
+	if q.Space != nil && q.Space.Max > 0 && size > q.Space.Free {
+		e := &QuotaExceededError{Size: size, Quota: "space", Available: q.Space.Free}
+		if q.Space.Showing == "periodic" && q.Periodic != nil {
+			e.ResetDate = q.Periodic.ResetDate
+		}
+		return e
+	}
+
+	return nil
+}
+
+// checkQuota fetches the upload quota of the user owning the upload target
+// and checks the size against it. The upload isn't stopped when the quota
+// can't be fetched, as Vimeo checks it anyway. Only the uploads of new videos,
+// to me/videos or users/{id}/videos, are checked: the owner of the video of a
+// new version isn't known.
+func checkQuota(ctx context.Context, c *Client, target string, size int64) error {
+	if c.Config == nil || !c.Config.CheckQuota {
+		return nil
+	}
+
+	target = strings.Trim(target, "/")
+	if !strings.HasSuffix(target, "/videos") {
+		return nil
+	}
+
+	var uid string
+	if owner := strings.TrimSuffix(target, "/videos"); owner != "me" {
+		uid = strings.TrimPrefix(owner, "users/")
+		if uid == owner || strings.Contains(uid, "/") {
+			return nil
+		}
+	}
+
+	user, _, err := c.Users.GetWithContext(ctx, uid, OptFields{"upload_quota"})
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		c.log(ctx, "vimeo: upload quota not checked", slog.String("error", err.Error()))
+		return nil
+	}
+
+	if user.UploadQuota == nil {
+		return nil
+	}
+
+	return user.UploadQuota.Check(size)
+}
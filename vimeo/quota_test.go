@@ -0,0 +1,128 @@
+package vimeo
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestUploadQuota_Check(t *testing.T) {
+	reset := time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC)
+	quota := &UploadQuota{
+		Space:    &QuotaSpace{QuotaUsage: QuotaUsage{Free: 300, Max: 1000, Used: 700}, Showing: "periodic"},
+		Periodic: &QuotaPeriodic{QuotaUsage: QuotaUsage{Free: 300, Max: 1000, Used: 700}, ResetDate: reset},
+		Lifetime: &QuotaUsage{Free: 5000, Max: 10000, Used: 5000},
+		FileSize: &QuotaFileSize{Max: 500},
+	}
+
+	tests := []struct {
+		size int64
+		want error
+	}{
+		{300, nil},
+		{301, &QuotaExceededError{Size: 301, Quota: "periodic", Available: 300, ResetDate: reset}},
+		{501, &QuotaExceededError{Size: 501, Quota: "filesize", Available: 500}},
+	}
+
+	for _, tt := range tests {
+		err := quota.Check(tt.size)
+		if fmt.Sprint(err) != fmt.Sprint(tt.want) {
+			t.Errorf("UploadQuota.Check(%v) returned %v, want %v", tt.size, err, tt.want)
+		}
+	}
+
+	unlimited := &UploadQuota{Space: &QuotaSpace{Showing: "lifetime"}}
+	if err := unlimited.Check(1 << 40); err != nil {
+		t.Errorf("UploadQuota.Check of an unlimited quota returned %v", err)
+	}
+}
+
+func TestUsersService_UploadVideo_quotaExceeded(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.CheckQuota = true
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{"fields": "upload_quota"})
+		fmt.Fprint(w, `{"upload_quota": {"space": {"free": 50, "max": 1000, "used": 950, "showing": "lifetime"}}}`)
+	})
+
+	mux.HandleFunc("/users/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Users.UploadVideo created a video over the quota")
+	})
+
+	_, _, err := client.Users.UploadVideoFromReader("1", bytes.NewReader(make([]byte, 100)), 100)
+	if !IsQuotaExceeded(err) {
+		t.Fatalf("Users.UploadVideoFromReader returned %v, want a quota error", err)
+	}
+
+	want := &QuotaExceededError{Size: 100, Quota: "space", Available: 50}
+	if e := err.(*QuotaExceededError); *e != *want {
+		t.Errorf("Users.UploadVideoFromReader returned %+v, want %+v", e, want)
+	}
+}
+
+func TestUsersService_UploadVideo_quotaUnavailable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.CheckQuota = true
+
+	content := []byte("0123456789")
+	mux.Handle("/upload", &tusServer{t: t, size: int64(len(content))})
+
+	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"uri": "/videos/1", "upload": {"approach": "tus", "upload_link": "%s/upload"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1"}`)
+	})
+
+	_, _, err := client.Users.UploadVideoFromReader("", bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Errorf("Users.UploadVideoFromReader returned unexpected error: %v", err)
+	}
+}
+
+func TestVideosService_ReplaceFileFromReader_quotaSkipped(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.CheckQuota = true
+
+	content := []byte("0123456789")
+	mux.Handle("/upload", &tusServer{t: t, size: int64(len(content))})
+
+	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Videos.ReplaceFileFromReader checked the quota of the authenticated user")
+	})
+
+	mux.HandleFunc("/videos/1/versions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"uri": "/videos/1/versions/2", "upload": {"approach": "tus", "upload_link": "%s/upload"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/videos/1/versions/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1/versions/2"}`)
+	})
+
+	_, _, err := client.Videos.ReplaceFileFromReader(1, "new.mp4", bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Errorf("Videos.ReplaceFileFromReader returned unexpected error: %v", err)
+	}
+}
+
+func TestTranscodeError_quotaExceeded(t *testing.T) {
+	err := &TranscodeError{Video: &Video{URI: "/videos/1"}, Status: VideoStatusQuotaExceeded}
+	if !IsQuotaExceeded(err) || !IsUploadFailed(err) {
+		t.Errorf("TranscodeError %v doesn't match ErrQuotaExceeded and ErrUploadFailed", err)
+	}
+}
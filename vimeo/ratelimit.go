@@ -0,0 +1,89 @@
+package vimeo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter throttles the requests of a Client using the rate limit reported
+// by Vimeo in the X-RateLimit headers. It is safe for concurrent use, so a single
+// RateLimiter paces every goroutine sharing the Client.
+//
+// While more than Threshold requests remain in the current window, requests are
+// sent immediately. Below Threshold, the remaining requests are spread evenly
+// until the window resets. When nothing remains, requests block until Reset.
+type RateLimiter struct {
+	// Threshold is the number of remaining requests below which requests are slowed down.
+	Threshold int
+
+	mu   sync.Mutex
+	rate Rate
+	next time.Time
+}
+
+// Rate returns the last rate limit reported by Vimeo.
+func (l *RateLimiter) Rate() Rate {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rate
+}
+
+// update records the rate limit of a response. Responses without rate headers are ignored.
+func (l *RateLimiter) update(rate Rate) {
+	if rate.Limit == 0 && rate.Reset.IsZero() {
+		return
+	}
+
+	l.mu.Lock()
+	l.rate = rate
+	l.mu.Unlock()
+}
+
+// reserve takes a request from the remaining rate and returns
+// how long the caller must wait before sending it.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate.Reset.IsZero() || !now.Before(l.rate.Reset) {
+		return 0
+	}
+
+	if l.rate.Remaining <= 0 {
+		return l.rate.Reset.Sub(now)
+	}
+
+	if l.rate.Remaining > l.Threshold {
+		l.rate.Remaining--
+		return 0
+	}
+
+	start := now
+	if l.next.After(start) {
+		start = l.next
+	}
+	l.next = start.Add(l.rate.Reset.Sub(start) / time.Duration(l.rate.Remaining+1))
+	l.rate.Remaining--
+
+	return start.Sub(now)
+}
+
+// wait blocks until a request may be sent or the context is done.
+func (l *RateLimiter) wait(ctx context.Context) error {
+	d := l.reserve(time.Now())
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+package vimeo
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDo_rate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "100")
+		w.Header().Set(headerRateRemaining, "99")
+		w.Header().Set(headerRateReset, "2017-09-14T09:47:00+00:00")
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	resp, err := client.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	want := Rate{
+		Limit:     100,
+		Remaining: 99,
+		Reset:     time.Date(2017, 9, 14, 9, 47, 0, 0, time.UTC),
+	}
+	if resp.Rate.Limit != want.Limit || resp.Rate.Remaining != want.Remaining || !resp.Rate.Reset.Equal(want.Reset) {
+		t.Errorf("Response.Rate is %+v, want %+v", resp.Rate, want)
+	}
+}
+
+func TestDo_rateLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	limiter := &RateLimiter{}
+	client.Config.RateLimiter = limiter
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "100")
+		w.Header().Set(headerRateRemaining, "42")
+		w.Header().Set(headerRateReset, time.Now().Add(time.Minute).Format(time.RFC3339))
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	if got := limiter.Rate().Remaining; got != 42 {
+		t.Errorf("RateLimiter.Rate remaining is %v, want %v", got, 42)
+	}
+
+	limiter.update(Rate{Limit: 100, Remaining: 0, Reset: time.Now().Add(time.Minute)})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ = client.NewRequestWithContext(ctx, "GET", "/", nil)
+	if _, err := client.Do(req, nil); err != context.DeadlineExceeded {
+		t.Errorf("Do returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRateLimiter_reserve(t *testing.T) {
+	now := time.Date(2017, 9, 14, 9, 47, 0, 0, time.UTC)
+	l := &RateLimiter{Threshold: 3}
+
+	if d := l.reserve(now); d != 0 {
+		t.Errorf("RateLimiter.reserve without rate is %v, want %v", d, 0)
+	}
+
+	l.update(Rate{Limit: 10, Remaining: 4, Reset: now.Add(4 * time.Second)})
+
+	if d := l.reserve(now); d != 0 {
+		t.Errorf("RateLimiter.reserve above threshold is %v, want %v", d, 0)
+	}
+
+	// 3 requests remaining within 4 seconds: one per second.
+	for i, want := range []time.Duration{0, time.Second, 2 * time.Second} {
+		if d := l.reserve(now); d != want {
+			t.Errorf("RateLimiter.reserve #%d is %v, want %v", i, d, want)
+		}
+	}
+
+	if d := l.reserve(now); d != 4*time.Second {
+		t.Errorf("RateLimiter.reserve when exhausted is %v, want %v", d, 4*time.Second)
+	}
+
+	if d := l.reserve(now.Add(5 * time.Second)); d != 0 {
+		t.Errorf("RateLimiter.reserve after reset is %v, want %v", d, 0)
+	}
+}
+
+func TestRateLimiter_concurrent(t *testing.T) {
+	l := &RateLimiter{}
+	l.update(Rate{Limit: 100, Remaining: 50, Reset: time.Now().Add(time.Minute)})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if d := l.reserve(time.Now()); d != 0 {
+				t.Errorf("RateLimiter.reserve is %v, want %v", d, 0)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := l.Rate().Remaining; got != 0 {
+		t.Errorf("RateLimiter.Rate remaining is %v, want %v", got, 0)
+	}
+}
@@ -0,0 +1,166 @@
+package vimeo
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const headerRetryAfter = "Retry-After"
+
+// HeaderIdempotencyKey marks a non-idempotent request (such as POST) as safe to retry.
+// Set it on the request to let Client.Do retry it like an idempotent one.
+const HeaderIdempotencyKey = "Idempotency-Key"
+
+// RetryPolicy describes how Client.Do retries failed requests.
+//
+// A request is retried when the transport returns an error or the response
+// status code is one of RetryableStatusCodes. Requests whose method is not in
+// RetryableMethods are only retried when they carry the Idempotency-Key header,
+// or when Vimeo rejected them with 429 Too Many Requests, because
+// such request has not been processed.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts. If the server asks to wait
+	// longer (Retry-After or X-RateLimit-Reset), the request is not retried.
+	MaxBackoff time.Duration
+
+	// Multiplier grows the delay after each attempt. Values below 1 are treated as 1.
+	Multiplier float64
+
+	// Jitter randomizes the delay by up to the given fraction (0 to 1) of its value.
+	Jitter float64
+
+	// RetryableStatusCodes lists the response status codes that are retried.
+	RetryableStatusCodes []int
+
+	// RetryableMethods lists the HTTP methods considered idempotent.
+	RetryableMethods []string
+}
+
+// DefaultRetryPolicy returns the retry policy used by DefaultConfig.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Multiplier:  2,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableMethods: []string{"GET", "HEAD", "OPTIONS", "PUT", "DELETE"},
+	}
+}
+
+// retry reports whether the request should be sent again after the given attempt,
+// and how long to wait before doing so.
+func (p *RetryPolicy) retry(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	if req.Context().Err() != nil {
+		return 0, false
+	}
+
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 0, false
+	}
+
+	rejected := false
+	if err == nil {
+		if !p.retryableStatus(resp.StatusCode) {
+			return 0, false
+		}
+		rejected = resp.StatusCode == http.StatusTooManyRequests
+	}
+
+	if !rejected && !p.idempotent(req) {
+		return 0, false
+	}
+
+	wait := p.backoff(attempt)
+	if resp != nil {
+		if after, ok := serverDelay(resp); ok {
+			if p.MaxBackoff > 0 && after > p.MaxBackoff {
+				return 0, false
+			}
+			if after > wait {
+				wait = after
+			}
+		}
+	}
+
+	return wait, true
+}
+
+func (p *RetryPolicy) retryableStatus(code int) bool {
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) idempotent(req *http.Request) bool {
+	if req.Header.Get(HeaderIdempotencyKey) != "" {
+		return true
+	}
+	for _, m := range p.RetryableMethods {
+		if m == req.Method {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the next attempt: exponential growth
+// from MinBackoff, capped by MaxBackoff and randomized by Jitter.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := math.Max(p.Multiplier, 1)
+	d := float64(p.MinBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		d -= d * math.Min(p.Jitter, 1) * rand.Float64() // nolint: gosec
+	}
+
+	return time.Duration(d)
+}
+
+// serverDelay returns the delay requested by the server through
+// the Retry-After header or, when the rate limit is exhausted, the rate limit reset time.
+func serverDelay(r *http.Response) (time.Duration, bool) {
+	if after := r.Header.Get(headerRetryAfter); after != "" {
+		if seconds, err := strconv.Atoi(after); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if t, err := http.ParseTime(after); err == nil {
+			return time.Until(t), true
+		}
+	}
+
+	if r.Header.Get(headerRateRemaining) == "0" {
+		if rate := parseRate(r); !rate.Reset.IsZero() {
+			return time.Until(rate.Reset), true
+		}
+	}
+
+	return 0, false
+}
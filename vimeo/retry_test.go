@@ -0,0 +1,208 @@
+package vimeo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.MinBackoff = time.Millisecond
+	p.MaxBackoff = 10 * time.Millisecond
+	return p
+}
+
+func TestDo_retry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.Retry = testRetryPolicy()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"name":"Test"}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	resp, err := client.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	if resp.Attempts != 3 {
+		t.Errorf("Response.Attempts is %v, want %v", resp.Attempts, 3)
+	}
+}
+
+func TestDo_retryExhausted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.Retry = testRetryPolicy()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, `{"error":"Bad gateway"}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	resp, err := client.Do(req, nil)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Fatalf("Expected a *ErrorResponse error; got %#v.", err)
+	}
+
+	if calls != 3 || resp.Attempts != 3 {
+		t.Errorf("Do sent %v requests (Attempts %v), want %v", calls, resp.Attempts, 3)
+	}
+}
+
+func TestDo_retryPOST(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.Retry = testRetryPolicy()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	req, _ := client.NewRequest("POST", "/", &UserRequest{Name: "name"})
+	_, err := client.Do(req, nil)
+	if err == nil {
+		t.Fatal("Expected HTTP error.")
+	}
+
+	if calls != 1 {
+		t.Errorf("Do sent POST %v times, want %v", calls, 1)
+	}
+
+	calls = 0
+	req, _ = client.NewRequest("POST", "/", &UserRequest{Name: "name"})
+	req.Header.Set(HeaderIdempotencyKey, "key")
+	_, err = client.Do(req, nil)
+	if err == nil {
+		t.Fatal("Expected HTTP error.")
+	}
+
+	if calls != 3 {
+		t.Errorf("Do sent POST with idempotency key %v times, want %v", calls, 3)
+	}
+}
+
+func TestDo_retryRewindsBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.Retry = testRetryPolicy()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		v := &UserRequest{}
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil || v.Name != "name" {
+			t.Errorf("Request body on attempt %v is %+v (%v)", calls, v, err)
+		}
+		if calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+	})
+
+	req, _ := client.NewRequest("POST", "/", &UserRequest{Name: "name"})
+	resp, err := client.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	if resp.Attempts != 2 {
+		t.Errorf("Response.Attempts is %v, want %v", resp.Attempts, 2)
+	}
+}
+
+func TestDo_retryAfterTooLong(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.Retry = testRetryPolicy()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set(headerRetryAfter, "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(req, nil); err == nil {
+		t.Fatal("Expected HTTP error.")
+	}
+
+	if calls != 1 {
+		t.Errorf("Do sent %v requests, want %v", calls, 1)
+	}
+}
+
+func TestDo_retryCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.Retry = testRetryPolicy()
+	client.Config.Retry.MinBackoff = time.Minute
+	client.Config.Retry.MaxBackoff = time.Minute
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := client.NewRequestWithContext(ctx, "GET", "/", nil)
+	if _, err := client.Do(req, nil); err != context.DeadlineExceeded {
+		t.Errorf("Do returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: time.Second,
+		Multiplier: 2,
+	}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{5, time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := p.backoff(tt.attempt); got != tt.want {
+			t.Errorf("RetryPolicy.backoff(%v) is %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := p.backoff(2); got < 100*time.Millisecond || got > 200*time.Millisecond {
+			t.Fatalf("RetryPolicy.backoff with jitter is %v, want between %v and %v", got, 100*time.Millisecond, 200*time.Millisecond)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package vimeo
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// UploadSession records an upload in progress, so that an upload interrupted
+// by the end of the process can be resumed by another one.
+type UploadSession struct {
+	// Key identifies the upload, see OptSessionKey.
+	Key string `json:"key"`
+
+	// Target is the API path on which the upload was created, such as me/videos.
+	Target string `json:"target"`
+
+	// VideoURI is the URI of the created video, or version when replacing the file of a video.
+	VideoURI   string    `json:"video_uri"`
+	UploadLink string    `json:"upload_link"`
+	Size       int64     `json:"size"`
+	Offset     int64     `json:"offset"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// SessionStore persists the sessions of uploads in progress.
+// Its methods may be called concurrently by different uploads.
+type SessionStore interface {
+	// Load returns the session recorded with the key, or nil if there is none.
+	Load(ctx context.Context, key string) (*UploadSession, error)
+
+	// Save records the session under its key, replacing any previous one.
+	Save(ctx context.Context, s *UploadSession) error
+
+	// Delete removes the session recorded with the key, if any.
+	Delete(ctx context.Context, key string) error
+}
+
+// FileSessionStore is a SessionStore keeping each session in a JSON file of Dir.
+type FileSessionStore struct {
+	Dir string
+}
+
+// Load returns the session recorded with the key, or nil if there is none.
+func (s *FileSessionStore) Load(ctx context.Context, key string) (*UploadSession, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	session := &UploadSession{}
+	if err := json.Unmarshal(data, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// Save records the session under its key, replacing any previous one.
+// The file is replaced atomically, so a crash never leaves a partial session.
+func (s *FileSessionStore) Save(ctx context.Context, session *UploadSession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.Dir, 0700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(s.Dir, ".session-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // nolint: errcheck
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close() // nolint: errcheck
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path(session.Key))
+}
+
+// Delete removes the session recorded with the key, if any.
+func (s *FileSessionStore) Delete(ctx context.Context, key string) error {
+	err := os.Remove(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (s *FileSessionStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// OptSessionKey is an optional argument to an upload, identifying it in Config.SessionStore.
+// An upload started with the same key, target and size resumes the recorded session
+// instead of creating a new video. Uploads from a file default to a key made of the
+// absolute path, size and modification time of the file.
+type OptSessionKey string
+
+func (o OptSessionKey) apply(u *uploadOptions) {
+	u.sessionKey = string(o)
+}
+
+// fileSessionKey returns the default session key of a file upload.
+func fileSessionKey(file *os.File, stat os.FileInfo) string {
+	name, err := filepath.Abs(file.Name())
+	if err != nil {
+		name = file.Name()
+	}
+
+	return fmt.Sprintf("%s:%d:%d", name, stat.Size(), stat.ModTime().UnixNano())
+}
+
+// resumeSession returns the recorded session of the upload if it can be resumed.
+// Sessions whose upload link expired are discarded.
+func (o *uploadOptions) resumeSession(ctx context.Context, c *Client, target string, size int64) (*UploadSession, error) {
+	if o.store == nil || o.sessionKey == "" {
+		return nil, nil
+	}
+
+	session, err := o.store.Load(ctx, o.sessionKey)
+	if err != nil || session == nil {
+		return nil, err
+	}
+
+	if session.Target != target || session.Size != size {
+		return nil, nil
+	}
+
+	offset, err := tusOffset(ctx, c, session.UploadLink)
+	if err != nil {
+		if uploadExpired(err) {
+			c.log(ctx, "vimeo: upload session expired", slog.String("video", session.VideoURI))
+			return nil, o.store.Delete(ctx, o.sessionKey)
+		}
+		return nil, err
+	}
+
+	session.Offset = offset
+	o.session = session
+
+	return session, nil
+}
+
+// newSession records a new upload.
+func (o *uploadOptions) newSession(ctx context.Context, target string, size int64, uri, uploadLink string) error {
+	if o.store == nil || o.sessionKey == "" {
+		return nil
+	}
+
+	now := time.Now()
+	o.session = &UploadSession{
+		Key:        o.sessionKey,
+		Target:     target,
+		VideoURI:   uri,
+		UploadLink: uploadLink,
+		Size:       size,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	return o.store.Save(ctx, o.session)
+}
+
+// checkpoint records the offset received by Vimeo in the session of the upload, if any.
+// A session which can't be saved doesn't stop the upload.
+func (o *uploadOptions) checkpoint(ctx context.Context, c *Client, offset int64) {
+	if o.session == nil || o.session.Offset == offset {
+		return
+	}
+
+	o.session.Offset = offset
+	o.session.UpdatedAt = time.Now()
+	if err := o.store.Save(ctx, o.session); err != nil {
+		c.log(ctx, "vimeo: upload session not saved",
+			slog.String("video", o.session.VideoURI),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// closeSession removes the session of a completed upload.
+func (o *uploadOptions) closeSession(ctx context.Context) error {
+	if o.session == nil {
+		return nil
+	}
+
+	return o.store.Delete(ctx, o.session.Key)
+}
+
+// uploadExpired reports whether err shows that an upload link no longer exists.
+func uploadExpired(err error) bool {
+	var e *ErrorResponse
+	if !errors.As(err, &e) || e.Response == nil {
+		return false
+	}
+
+	return e.Response.StatusCode == http.StatusNotFound || e.Response.StatusCode == http.StatusGone
+}
@@ -0,0 +1,154 @@
+package vimeo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFileSessionStore(t *testing.T) {
+	ctx := context.Background()
+	store := &FileSessionStore{Dir: t.TempDir() + "/sessions"}
+
+	session, err := store.Load(ctx, "key")
+	if err != nil || session != nil {
+		t.Fatalf("FileSessionStore.Load returned %+v, %v, want nil, nil", session, err)
+	}
+
+	want := &UploadSession{
+		Key:        "key",
+		Target:     "me/videos",
+		VideoURI:   "/videos/1",
+		UploadLink: "https://upload.example.com/1",
+		Size:       100,
+		Offset:     40,
+		CreatedAt:  time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:  time.Date(2019, 1, 1, 0, 1, 0, 0, time.UTC),
+	}
+	if err := store.Save(ctx, want); err != nil {
+		t.Fatalf("FileSessionStore.Save returned unexpected error: %v", err)
+	}
+
+	session, err = store.Load(ctx, "key")
+	if err != nil {
+		t.Fatalf("FileSessionStore.Load returned unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(session, want) {
+		t.Errorf("FileSessionStore.Load returned %+v, want %+v", session, want)
+	}
+
+	if err := store.Delete(ctx, "key"); err != nil {
+		t.Fatalf("FileSessionStore.Delete returned unexpected error: %v", err)
+	}
+	if err := store.Delete(ctx, "key"); err != nil {
+		t.Errorf("FileSessionStore.Delete of a missing session returned unexpected error: %v", err)
+	}
+
+	session, _ = store.Load(ctx, "key")
+	if session != nil {
+		t.Errorf("FileSessionStore.Load after Delete returned %+v, want nil", session)
+	}
+}
+
+func TestUsersService_UploadVideo_resumeSession(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	tus := &tusServer{t: t, size: int64(len(content)), failPatch: 2}
+	mux.Handle("/upload", tus)
+
+	created := 0
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		created++
+		fmt.Fprintf(w, `{"uri": "/videos/1", "upload": {"approach": "tus", "upload_link": "%s/upload"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1"}`)
+	})
+
+	ctx := context.Background()
+	store := &FileSessionStore{Dir: t.TempDir()}
+	client.Config.SessionStore = store
+	client.Config.Uploader = &TusUploader{ChunkSize: 30}
+
+	// The first process fails on the second chunk.
+	_, _, err := client.Users.UploadVideoFromReader("", bytes.NewReader(content), int64(len(content)), OptSessionKey("key"))
+	if err == nil {
+		t.Fatal("Users.UploadVideoFromReader returned no error, want the failure of the second chunk")
+	}
+
+	session, _ := store.Load(ctx, "key")
+	if session == nil || session.Offset != 30 || session.VideoURI != "/videos/1" {
+		t.Fatalf("Recorded session is %+v, want offset 30 of /videos/1", session)
+	}
+
+	// The next process resumes the upload.
+	_, _, err = client.Users.UploadVideoFromReader("", bytes.NewReader(content), int64(len(content)), OptSessionKey("key"))
+	if err != nil {
+		t.Fatalf("Users.UploadVideoFromReader returned unexpected error: %v", err)
+	}
+
+	if created != 1 {
+		t.Errorf("Users.UploadVideoFromReader created %v videos, want 1", created)
+	}
+
+	if !bytes.Equal(tus.data, content) {
+		t.Errorf("Uploaded data is %q, want %q", tus.data, content)
+	}
+
+	if session, _ := store.Load(ctx, "key"); session != nil {
+		t.Errorf("Session of the completed upload is %+v, want nil", session)
+	}
+}
+
+func TestUsersService_UploadVideo_expiredSession(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := []byte("0123456789")
+	tus := &tusServer{t: t, size: int64(len(content))}
+	mux.Handle("/upload", tus)
+
+	mux.HandleFunc("/expired", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"uri": "/videos/2", "upload": {"approach": "tus", "upload_link": "%s/upload"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/videos/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/2"}`)
+	})
+
+	ctx := context.Background()
+	store := &FileSessionStore{Dir: t.TempDir()}
+	store.Save(ctx, &UploadSession{
+		Key:        "key",
+		Target:     "me/videos",
+		VideoURI:   "/videos/1",
+		UploadLink: server.URL + "/expired",
+		Size:       int64(len(content)),
+	})
+	client.Config.SessionStore = store
+
+	video, _, err := client.Users.UploadVideoFromReader("", bytes.NewReader(content), int64(len(content)), OptSessionKey("key"))
+	if err != nil {
+		t.Fatalf("Users.UploadVideoFromReader returned unexpected error: %v", err)
+	}
+
+	if video.URI != "/videos/2" {
+		t.Errorf("Users.UploadVideoFromReader returned %+v, want a new video", video)
+	}
+
+	if !bytes.Equal(tus.data, content) {
+		t.Errorf("Uploaded data is %q, want %q", tus.data, content)
+	}
+}
@@ -1,6 +1,9 @@
 package vimeo
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // TagsService handles communication with the tag related
 // methods of the Vimeo API.
@@ -22,13 +25,13 @@ type Tag struct {
 	ResourceKey string `json:"resource_key,omitempty"`
 }
 
-func listTag(c *Client, url string, opt ...CallOption) ([]*Tag, *Response, error) {
+func listTag(ctx context.Context, c *Client, url string, opt ...CallOption) ([]*Tag, *Response, error) {
 	u, err := addOptions(url, opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -45,13 +48,13 @@ func listTag(c *Client, url string, opt ...CallOption) ([]*Tag, *Response, error
 	return tags.Data, resp, err
 }
 
-func getTag(c *Client, url string, opt ...CallOption) (*Tag, *Response, error) {
+func getTag(ctx context.Context, c *Client, url string, opt ...CallOption) (*Tag, *Response, error) {
 	u, err := addOptions(url, opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -70,8 +73,13 @@ func getTag(c *Client, url string, opt ...CallOption) (*Tag, *Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/tags#get_tag
 func (s *TagsService) Get(t string, opt ...CallOption) (*Tag, *Response, error) {
+	return s.GetWithContext(context.Background(), t, opt...)
+}
+
+// GetWithContext method is the same as Get, with the addition of the ability to pass a context.
+func (s *TagsService) GetWithContext(ctx context.Context, t string, opt ...CallOption) (*Tag, *Response, error) {
 	u := fmt.Sprintf("tags/%s", t)
-	tag, resp, err := getTag(s.client, u, opt...)
+	tag, resp, err := getTag(ctx, s.client, u, opt...)
 
 	return tag, resp, err
 }
@@ -80,8 +88,13 @@ func (s *TagsService) Get(t string, opt ...CallOption) (*Tag, *Response, error)
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/tags#get_tag_videos
 func (s *TagsService) ListVideo(t string, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.ListVideoWithContext(context.Background(), t, opt...)
+}
+
+// ListVideoWithContext method is the same as ListVideo, with the addition of the ability to pass a context.
+func (s *TagsService) ListVideoWithContext(ctx context.Context, t string, opt ...CallOption) ([]*Video, *Response, error) {
 	u := fmt.Sprintf("tags/%s/videos", t)
-	videos, resp, err := listVideo(s.client, u, opt...)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
 
 	return videos, resp, err
 }
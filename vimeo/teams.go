@@ -0,0 +1,255 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TeamsService handles communication with the team related
+// methods of the Vimeo API. The members of a team account are
+// managed by its owner and admins.
+// Passing the empty string as uid uses the authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams
+type TeamsService service
+
+type dataListTeamMember struct {
+	Data []*TeamMember `json:"data"`
+	pagination
+}
+
+// TeamRole is the permission level of a team member.
+type TeamRole string
+
+// Roles of team members.
+const (
+	TeamRoleOwner       TeamRole = "owner"
+	TeamRoleAdmin       TeamRole = "admin"
+	TeamRoleContributor TeamRole = "contributor"
+	TeamRoleUploader    TeamRole = "uploader"
+	TeamRoleViewer      TeamRole = "viewer"
+)
+
+// Statuses of team members.
+const (
+	TeamMemberActive  = "active"
+	TeamMemberPending = "pending"
+)
+
+// TeamMember represents a member of a team, or an invitation to join it while its status is pending.
+type TeamMember struct {
+	URI             string    `json:"uri,omitempty"`
+	Email           string    `json:"email,omitempty"`
+	Role            TeamRole  `json:"permission_level,omitempty"`
+	Status          string    `json:"status,omitempty"`
+	HasFolderAccess bool      `json:"has_folder_access,omitempty"`
+	CreatedTime     time.Time `json:"created_time,omitempty"`
+	ModifiedTime    time.Time `json:"modified_time,omitempty"`
+	User            *User     `json:"user,omitempty"`
+	InvitedBy       *User     `json:"invited_by,omitempty"`
+	ResourceKey     string    `json:"resource_key,omitempty"`
+}
+
+// TeamInviteRequest represents a request to invite a member to a team.
+// FolderURI grants the access to a folder to a contributor or viewer.
+type TeamInviteRequest struct {
+	Email     string   `json:"email"`
+	Role      TeamRole `json:"permission_level"`
+	FolderURI string   `json:"folder_uri,omitempty"`
+	Message   string   `json:"custom_message,omitempty"`
+	Locale    string   `json:"locale,omitempty"`
+}
+
+// TeamMemberRequest represents a request to edit a team member.
+type TeamMemberRequest struct {
+	Role      TeamRole `json:"permission_level,omitempty"`
+	FolderURI string   `json:"folder_uri,omitempty"`
+}
+
+// GetID returns the identifier (ID) of the team member.
+func (m TeamMember) GetID() string {
+	l := strings.Split(m.URI, "/")
+	return l[len(l)-1]
+}
+
+// teamURL returns the URL of the team members of the specified user, followed by path.
+func teamURL(uid string, path string) string {
+	if uid == "" {
+		return "me/team_users" + path
+	}
+	return fmt.Sprintf("users/%s/team_users%s", uid, path)
+}
+
+// ListMember method gets the members of the team of the specified user, including the pending invitations.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#get_team_members
+func (s *TeamsService) ListMember(uid string, opt ...CallOption) ([]*TeamMember, *Response, error) {
+	return s.ListMemberWithContext(context.Background(), uid, opt...)
+}
+
+// ListMemberWithContext method is the same as ListMember, with the addition of the ability to pass a context.
+func (s *TeamsService) ListMemberWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*TeamMember, *Response, error) {
+	u, err := addOptions(teamURL(uid, ""), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members := &dataListTeamMember{}
+
+	resp, err := s.client.Do(req, members)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(members)
+
+	return members.Data, resp, err
+}
+
+// GetMember method gets a single team member.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#get_team_member
+func (s *TeamsService) GetMember(uid string, mid string, opt ...CallOption) (*TeamMember, *Response, error) {
+	return s.GetMemberWithContext(context.Background(), uid, mid, opt...)
+}
+
+// GetMemberWithContext method is the same as GetMember, with the addition of the ability to pass a context.
+func (s *TeamsService) GetMemberWithContext(ctx context.Context, uid string, mid string, opt ...CallOption) (*TeamMember, *Response, error) {
+	u, err := addOptions(teamURL(uid, "/"+mid), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := &TeamMember{}
+
+	resp, err := s.client.Do(req, member)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return member, resp, err
+}
+
+// InviteMember method invites a member to the team of the specified user.
+// The member is pending until the invitation is accepted.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#invite_team_member
+func (s *TeamsService) InviteMember(uid string, r *TeamInviteRequest) (*TeamMember, *Response, error) {
+	return s.InviteMemberWithContext(context.Background(), uid, r)
+}
+
+// InviteMemberWithContext method is the same as InviteMember, with the addition of the ability to pass a context.
+func (s *TeamsService) InviteMemberWithContext(ctx context.Context, uid string, r *TeamInviteRequest) (*TeamMember, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", teamURL(uid, ""), r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := &TeamMember{}
+	resp, err := s.client.Do(req, member)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return member, resp, nil
+}
+
+// ResendInvite method sends the invitation of a pending team member again.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#resend_team_invite
+func (s *TeamsService) ResendInvite(uid string, mid string) (*Response, error) {
+	return s.ResendInviteWithContext(context.Background(), uid, mid)
+}
+
+// ResendInviteWithContext method is the same as ResendInvite, with the addition of the ability to pass a context.
+func (s *TeamsService) ResendInviteWithContext(ctx context.Context, uid string, mid string) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", teamURL(uid, "/"+mid+"/resend"), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// EditMember method edits a team member, changing its role.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#edit_team_member
+func (s *TeamsService) EditMember(uid string, mid string, r *TeamMemberRequest) (*TeamMember, *Response, error) {
+	return s.EditMemberWithContext(context.Background(), uid, mid, r)
+}
+
+// EditMemberWithContext method is the same as EditMember, with the addition of the ability to pass a context.
+func (s *TeamsService) EditMemberWithContext(ctx context.Context, uid string, mid string, r *TeamMemberRequest) (*TeamMember, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", teamURL(uid, "/"+mid), r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := &TeamMember{}
+	resp, err := s.client.Do(req, member)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return member, resp, nil
+}
+
+// RemoveMember method removes a member from the team, or cancels the invitation of a pending member.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#remove_team_member
+func (s *TeamsService) RemoveMember(uid string, mid string) (*Response, error) {
+	return s.RemoveMemberWithContext(context.Background(), uid, mid)
+}
+
+// RemoveMemberWithContext method is the same as RemoveMember, with the addition of the ability to pass a context.
+func (s *TeamsService) RemoveMemberWithContext(ctx context.Context, uid string, mid string) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", teamURL(uid, "/"+mid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListMemberFolder method gets the folders a team member can access.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#get_team_member_folders
+func (s *TeamsService) ListMemberFolder(uid string, mid string, opt ...CallOption) ([]*Folder, *Response, error) {
+	return s.ListMemberFolderWithContext(context.Background(), uid, mid, opt...)
+}
+
+// ListMemberFolderWithContext method is the same as ListMemberFolder, with the addition of the ability to pass a context.
+func (s *TeamsService) ListMemberFolderWithContext(ctx context.Context, uid string, mid string, opt ...CallOption) ([]*Folder, *Response, error) {
+	u, err := addOptions(teamURL(uid, "/"+mid+"/folders"), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	folders := &dataListFolder{}
+
+	resp, err := s.client.Do(req, folders)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(folders)
+
+	return folders.Data, resp, err
+}
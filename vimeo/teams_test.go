@@ -0,0 +1,183 @@
+package vimeo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTeamMember_GetID(t *testing.T) {
+	v := &TeamMember{URI: "/users/1/team_users/2"}
+
+	if id := v.GetID(); id != "2" {
+		t.Errorf("TeamMember.GetID returned %+v, want %+v", id, "2")
+	}
+}
+
+func TestTeamsService_ListMember(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/team_users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"page":     "2",
+			"per_page": "1",
+		})
+		fmt.Fprint(w, `{"total": 3, "page": 2, "paging": {"next": "/me/team_users?page=3"}, "data": [{"email": "a@example.com", "permission_level": "admin", "status": "active"}]}`)
+	})
+
+	members, resp, err := client.Teams.ListMember("", OptPage(2), OptPerPage(1))
+	if err != nil {
+		t.Errorf("Teams.ListMember returned unexpected error: %v", err)
+	}
+
+	want := []*TeamMember{{Email: "a@example.com", Role: TeamRoleAdmin, Status: TeamMemberActive}}
+	if !reflect.DeepEqual(members, want) {
+		t.Errorf("Teams.ListMember returned %+v, want %+v", members, want)
+	}
+
+	if resp.NextPage != "/me/team_users?page=3" {
+		t.Errorf("Teams.ListMember next page is %v, want %v", resp.NextPage, "/me/team_users?page=3")
+	}
+}
+
+func TestTeamsService_GetMember(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/team_users/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"email": "a@example.com"}`)
+	})
+
+	member, _, err := client.Teams.GetMember("1", "2")
+	if err != nil {
+		t.Errorf("Teams.GetMember returned unexpected error: %v", err)
+	}
+
+	want := &TeamMember{Email: "a@example.com"}
+	if !reflect.DeepEqual(member, want) {
+		t.Errorf("Teams.GetMember returned %+v, want %+v", member, want)
+	}
+}
+
+func TestTeamsService_InviteMember(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &TeamInviteRequest{
+		Email:     "a@example.com",
+		Role:      TeamRoleViewer,
+		FolderURI: "/users/1/projects/2",
+	}
+
+	mux.HandleFunc("/me/team_users", func(w http.ResponseWriter, r *http.Request) {
+		v := &TeamInviteRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("Teams.InviteMember returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Teams.InviteMember body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"email": "a@example.com", "permission_level": "viewer", "status": "pending"}`)
+	})
+
+	member, _, err := client.Teams.InviteMember("", input)
+	if err != nil {
+		t.Errorf("Teams.InviteMember returned unexpected error: %v", err)
+	}
+
+	want := &TeamMember{Email: "a@example.com", Role: TeamRoleViewer, Status: TeamMemberPending}
+	if !reflect.DeepEqual(member, want) {
+		t.Errorf("Teams.InviteMember returned %+v, want %+v", member, want)
+	}
+}
+
+func TestTeamsService_ResendInvite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/team_users/2/resend", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+	})
+
+	_, err := client.Teams.ResendInvite("", "2")
+	if err != nil {
+		t.Errorf("Teams.ResendInvite returned unexpected error: %v", err)
+	}
+}
+
+func TestTeamsService_EditMember(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &TeamMemberRequest{
+		Role: TeamRoleContributor,
+	}
+
+	mux.HandleFunc("/me/team_users/2", func(w http.ResponseWriter, r *http.Request) {
+		v := &TeamMemberRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("Teams.EditMember returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Teams.EditMember body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"permission_level": "contributor"}`)
+	})
+
+	member, _, err := client.Teams.EditMember("", "2", input)
+	if err != nil {
+		t.Errorf("Teams.EditMember returned unexpected error: %v", err)
+	}
+
+	want := &TeamMember{Role: TeamRoleContributor}
+	if !reflect.DeepEqual(member, want) {
+		t.Errorf("Teams.EditMember returned %+v, want %+v", member, want)
+	}
+}
+
+func TestTeamsService_RemoveMember(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/team_users/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Teams.RemoveMember("", "2")
+	if err != nil {
+		t.Errorf("Teams.RemoveMember returned unexpected error: %v", err)
+	}
+}
+
+func TestTeamsService_ListMemberFolder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/team_users/2/folders", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	folders, _, err := client.Teams.ListMemberFolder("", "2")
+	if err != nil {
+		t.Errorf("Teams.ListMemberFolder returned unexpected error: %v", err)
+	}
+
+	want := []*Folder{{Name: "Test"}}
+	if !reflect.DeepEqual(folders, want) {
+		t.Errorf("Teams.ListMemberFolder returned %+v, want %+v", folders, want)
+	}
+}
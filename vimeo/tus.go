@@ -0,0 +1,302 @@
+package vimeo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+)
+
+const (
+	tusVersion = "1.0.0"
+
+	headerTusResumable = "Tus-Resumable"
+	headerUploadOffset = "Upload-Offset"
+	headerUploadLength = "Upload-Length"
+
+	mediaTypeOffsetOctetStream = "application/offset+octet-stream"
+
+	// DefaultChunkSize is the size of the chunks sent by TusUploader when ChunkSize is not set.
+	DefaultChunkSize = 16 << 20
+)
+
+// TusUploader is an Uploader implementing the tus 1.0 resumable upload protocol
+// used by Vimeo (https://tus.io/protocols/resumable-upload.html).
+//
+// The file is sent in chunks of ChunkSize bytes with PATCH requests. Before
+// the first chunk, and after a chunk fails, the upload offset is fetched
+// with a HEAD request so the upload resumes from the last byte received by Vimeo.
+// The progress is reported after every chunk.
+type TusUploader struct {
+	// ChunkSize is the size of each PATCH request. Defaults to DefaultChunkSize.
+	ChunkSize int64
+
+	// MaxResumes is the number of times a failed chunk is resumed before
+	// the upload is abandoned. Zero disables resuming.
+	MaxResumes int
+}
+
+// UploadFromFile uploads the file to the tus upload link.
+func (u *TusUploader) UploadFromFile(c *Client, uploadURL string, f *os.File) error {
+	return u.UploadFromFileWithContext(context.Background(), c, uploadURL, f)
+}
+
+// UploadFromFileWithContext method is the same as UploadFromFile, with the addition of the ability to pass a context.
+func (u *TusUploader) UploadFromFileWithContext(ctx context.Context, c *Client, uploadURL string, f *os.File) error {
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	return u.UploadFromReader(ctx, c, uploadURL, f, stat.Size())
+}
+
+// UploadFromReader uploads size bytes read from r to the tus upload link.
+// If r implements io.ReaderAt, chunks are read at the offset reported by the server,
+// so an upload started by another process can be resumed. Otherwise the current chunk
+// is buffered in memory to be resent, and the bytes already received by the server
+// are skipped from the start of r.
+func (u *TusUploader) UploadFromReader(ctx context.Context, c *Client, uploadURL string, r io.Reader, size int64) error {
+	opts := uploadOptionsFrom(ctx, c)
+
+	var src tusSource
+	if ra, ok := r.(io.ReaderAt); ok {
+		src = &readerAtSource{r: ra, sum: opts.checksum}
+	} else {
+		stream := &streamSource{r: r}
+		if opts.checksum != nil {
+			stream.r = io.TeeReader(r, opts.checksum)
+			stream.drain = true
+		}
+		src = stream
+	}
+
+	return u.upload(ctx, c, uploadURL, src, size, opts)
+}
+
+func (u *TusUploader) chunkSize() int64 {
+	if u.ChunkSize > 0 {
+		return u.ChunkSize
+	}
+	return DefaultChunkSize
+}
+
+// upload sends size bytes of src, starting from the offset known by the server.
+func (u *TusUploader) upload(ctx context.Context, c *Client, uploadURL string, src tusSource, size int64, opts *uploadOptions) error {
+	offset, err := tusOffset(ctx, c, uploadURL)
+	if err != nil {
+		return err
+	}
+
+	opts.checkpoint(ctx, c, offset)
+	progress := newProgressTracker(opts.progress, size, offset)
+
+	resumes := 0
+	for offset < size {
+		n := u.chunkSize()
+		if rest := size - offset; rest < n {
+			n = rest
+		}
+
+		chunk, err := src.chunk(offset, n)
+		if err != nil {
+			return err
+		}
+
+		next, err := tusPatch(ctx, c, uploadURL, chunk, offset, opts.bandwidth)
+		if err == nil {
+			opts.checkpoint(ctx, c, next)
+			progress.sent(next, next-offset)
+			offset = next
+			resumes = 0
+			continue
+		}
+
+		if ctx.Err() != nil || resumes >= u.MaxResumes {
+			return err
+		}
+		resumes++
+
+		offset, err = tusOffset(ctx, c, uploadURL)
+		if err != nil {
+			return err
+		}
+	}
+
+	return src.finish(size)
+}
+
+// tusSource provides the bytes of an upload.
+type tusSource interface {
+	// chunk returns up to n bytes starting at offset.
+	chunk(offset, n int64) (*io.SectionReader, error)
+
+	// finish reads the bytes of the upload which were not read,
+	// so that the checksum of the upload, if any, covers them.
+	finish(size int64) error
+}
+
+// readerAtSource reads chunks at any offset.
+// The checksum is computed from the start of the reader as chunks are read.
+type readerAtSource struct {
+	r   io.ReaderAt
+	sum *checksum
+}
+
+func (s *readerAtSource) chunk(offset, n int64) (*io.SectionReader, error) {
+	if s.sum != nil {
+		if err := s.sum.fill(s.r, offset+n); err != nil {
+			return nil, err
+		}
+	}
+
+	return io.NewSectionReader(s.r, offset, n), nil
+}
+
+func (s *readerAtSource) finish(size int64) error {
+	if s.sum == nil {
+		return nil
+	}
+	return s.sum.fill(s.r, size)
+}
+
+// streamSource reads chunks sequentially, keeping the last one in memory.
+type streamSource struct {
+	r    io.Reader
+	pos  int64 // offset of buf in the stream
+	buf  []byte
+	read int64 // number of bytes read from r
+
+	// drain makes finish read the rest of r, for its checksum.
+	drain bool
+}
+
+func (s *streamSource) chunk(offset, n int64) (*io.SectionReader, error) {
+	end := s.pos + int64(len(s.buf))
+
+	if offset < s.pos {
+		return nil, fmt.Errorf("tus: cannot rewind the reader to offset %d", offset)
+	}
+
+	if offset < end {
+		b := s.buf[offset-s.pos:]
+		if int64(len(b)) > n {
+			b = b[:n]
+		}
+		return io.NewSectionReader(bytes.NewReader(b), 0, int64(len(b))), nil
+	}
+
+	if skip := offset - end; skip > 0 {
+		n, err := io.CopyN(io.Discard, s.r, skip)
+		s.read += n
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if int64(cap(s.buf)) < n {
+		s.buf = make([]byte, n)
+	}
+	s.buf = s.buf[:n]
+	s.pos = offset
+
+	m, err := io.ReadFull(s.r, s.buf)
+	s.read += int64(m)
+	if err != nil {
+		s.buf = s.buf[:0]
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	return io.NewSectionReader(bytes.NewReader(s.buf), 0, n), nil
+}
+
+func (s *streamSource) finish(size int64) error {
+	if !s.drain || s.read >= size {
+		return nil
+	}
+
+	n, err := io.CopyN(io.Discard, s.r, size-s.read)
+	s.read += n
+	return err
+}
+
+// tusOffset returns the number of bytes already received by the server.
+func tusOffset(ctx context.Context, c *Client, uploadURL string) (int64, error) {
+	req, err := newTusRequest(ctx, c, "HEAD", uploadURL, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return parseUploadOffset(resp.Header)
+}
+
+// tusPatch sends the chunk at the given offset, limited to the bandwidth, and returns the new offset.
+func tusPatch(ctx context.Context, c *Client, uploadURL string, chunk *io.SectionReader, offset int64, bw *Bandwidth) (int64, error) {
+	req, err := newTusRequest(ctx, c, "PATCH", uploadURL, bw.reader(ctx, chunk))
+	if err != nil {
+		return 0, err
+	}
+
+	req.ContentLength = chunk.Size()
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bw.reader(ctx, io.NewSectionReader(chunk, 0, chunk.Size()))), nil
+	}
+	req.Header.Set("Content-Type", mediaTypeOffsetOctetStream)
+	req.Header.Set(headerUploadOffset, strconv.FormatInt(offset, 10))
+
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	next, err := parseUploadOffset(resp.Header)
+	if err != nil {
+		return 0, err
+	}
+
+	if next <= offset {
+		return 0, fmt.Errorf("tus: upload offset did not advance from %d", offset)
+	}
+
+	return next, nil
+}
+
+func newTusRequest(ctx context.Context, c *Client, method, uploadURL string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, uploadURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set(headerTusResumable, tusVersion)
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	return req, nil
+}
+
+func parseUploadOffset(h http.Header) (int64, error) {
+	v := h.Get(headerUploadOffset)
+	if v == "" {
+		return 0, errors.New("tus: missing Upload-Offset header")
+	}
+
+	offset, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("tus: invalid Upload-Offset header %q", v)
+	}
+
+	return offset, nil
+}
@@ -0,0 +1,409 @@
+package vimeo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// tusServer is a minimal tus 1.0 server keeping a single upload in memory.
+type tusServer struct {
+	t    *testing.T
+	size int64
+
+	mu      sync.Mutex
+	data    []byte
+	patches int
+	// failPatch makes the given PATCH request (1-based) store half of
+	// the chunk and then fail, as an interrupted connection would.
+	failPatch int
+}
+
+func (s *tusServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if got := r.Header.Get(headerTusResumable); got != tusVersion {
+		s.t.Errorf("Request Tus-Resumable header is %q, want %q", got, tusVersion)
+	}
+	w.Header().Set(headerTusResumable, tusVersion)
+
+	switch r.Method {
+	case "HEAD":
+		w.Header().Set(headerUploadOffset, strconv.Itoa(len(s.data)))
+		w.Header().Set(headerUploadLength, strconv.FormatInt(s.size, 10))
+	case "PATCH":
+		s.patches++
+		if got := r.Header.Get("Content-Type"); got != mediaTypeOffsetOctetStream {
+			s.t.Errorf("Request Content-Type is %q, want %q", got, mediaTypeOffsetOctetStream)
+		}
+
+		offset, _ := strconv.Atoi(r.Header.Get(headerUploadOffset))
+		if offset != len(s.data) {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		chunk, _ := ioutil.ReadAll(r.Body)
+		if s.patches == s.failPatch {
+			s.data = append(s.data, chunk[:len(chunk)/2]...)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		s.data = append(s.data, chunk...)
+		w.Header().Set(headerUploadOffset, strconv.Itoa(len(s.data)))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.t.Errorf("Unexpected tus request method %v", r.Method)
+	}
+}
+
+func testFile(t *testing.T, content []byte) *os.File {
+	name := filepath.Join(t.TempDir(), "video.mp4")
+	if err := ioutil.WriteFile(name, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+
+	return f
+}
+
+func TestTusUploader_UploadFromFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	tus := &tusServer{t: t, size: int64(len(content))}
+	mux.Handle("/upload", tus)
+
+	uploader := &TusUploader{ChunkSize: 30}
+	err := uploader.UploadFromFile(client, server.URL+"/upload", testFile(t, content))
+	if err != nil {
+		t.Fatalf("TusUploader.UploadFromFile returned unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(tus.data, content) {
+		t.Errorf("Uploaded data is %q, want %q", tus.data, content)
+	}
+
+	if tus.patches != 4 {
+		t.Errorf("TusUploader sent %v chunks, want %v", tus.patches, 4)
+	}
+}
+
+func TestTusUploader_resume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	tus := &tusServer{t: t, size: int64(len(content)), failPatch: 2}
+	mux.Handle("/upload", tus)
+
+	// An upload interrupted by a previous process.
+	tus.data = append(tus.data, content[:25]...)
+
+	uploader := &TusUploader{ChunkSize: 30, MaxResumes: 1}
+	err := uploader.UploadFromFile(client, server.URL+"/upload", testFile(t, content))
+	if err != nil {
+		t.Fatalf("TusUploader.UploadFromFile returned unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(tus.data, content) {
+		t.Errorf("Uploaded data is %q, want %q", tus.data, content)
+	}
+}
+
+// streamReader hides the io.ReaderAt implementation of its reader.
+type streamReader struct {
+	io.Reader
+}
+
+func TestTusUploader_UploadFromReader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	tus := &tusServer{t: t, size: int64(len(content)), failPatch: 2}
+	mux.Handle("/upload", tus)
+
+	// An upload interrupted by a previous process.
+	tus.data = append(tus.data, content[:25]...)
+
+	uploader := &TusUploader{ChunkSize: 30, MaxResumes: 1}
+	r := streamReader{bytes.NewReader(content)}
+	err := uploader.UploadFromReader(context.Background(), client, server.URL+"/upload", r, int64(len(content)))
+	if err != nil {
+		t.Fatalf("TusUploader.UploadFromReader returned unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(tus.data, content) {
+		t.Errorf("Uploaded data is %q, want %q", tus.data, content)
+	}
+}
+
+func TestTusUploader_UploadFromReader_short(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := []byte("0123456789")
+	mux.Handle("/upload", &tusServer{t: t, size: 20})
+
+	uploader := &TusUploader{}
+	r := streamReader{bytes.NewReader(content)}
+	err := uploader.UploadFromReader(context.Background(), client, server.URL+"/upload", r, 20)
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("TusUploader.UploadFromReader returned %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestTusUploader_resumeExhausted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	tus := &tusServer{t: t, size: int64(len(content)), failPatch: 1}
+	mux.Handle("/upload", tus)
+
+	uploader := &TusUploader{ChunkSize: 30}
+	err := uploader.UploadFromFile(client, server.URL+"/upload", testFile(t, content))
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("TusUploader.UploadFromFile returned %#v, want *ErrorResponse", err)
+	}
+}
+
+func TestTusUploader_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := []byte("0123456789")
+	mux.Handle("/upload", &tusServer{t: t, size: int64(len(content))})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	uploader := &TusUploader{}
+	err := uploader.UploadFromFileWithContext(ctx, client, server.URL+"/upload", testFile(t, content))
+	if err != context.Canceled {
+		t.Errorf("TusUploader.UploadFromFileWithContext returned %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestUsersService_UploadVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	tus := &tusServer{t: t, size: int64(len(content))}
+	mux.Handle("/upload", tus)
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &UploadVideoRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+		want := &UploadVideoRequest{Name: "video.mp4", Upload: &Upload{Approach: "tus", Size: int64(len(content))}}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Users.UploadVideo body is %+v, want %+v", v, want)
+		}
+
+		fmt.Fprintf(w, `{"uri": "/videos/1", "upload": {"approach": "tus", "upload_link": "%s/upload"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uri": "/videos/1", "name": "video.mp4"}`)
+	})
+
+	video, _, err := client.Users.UploadVideo("", testFile(t, content))
+	if err != nil {
+		t.Fatalf("Users.UploadVideo returned unexpected error: %v", err)
+	}
+
+	if video.URI != "/videos/1" {
+		t.Errorf("Users.UploadVideo returned %+v", video)
+	}
+
+	if !bytes.Equal(tus.data, content) {
+		t.Errorf("Uploaded data is %q, want %q", tus.data, content)
+	}
+}
+
+func TestUsersService_UploadVideoFromReader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	tus := &tusServer{t: t, size: int64(len(content))}
+	mux.Handle("/upload", tus)
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &UploadVideoRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+		want := &UploadVideoRequest{Upload: &Upload{Approach: "tus", Size: int64(len(content))}}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Users.UploadVideoFromReader body is %+v, want %+v", v, want)
+		}
+
+		fmt.Fprintf(w, `{"uri": "/videos/1", "upload": {"approach": "tus", "upload_link": "%s/upload"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uri": "/videos/1"}`)
+	})
+
+	video, _, err := client.Users.UploadVideoFromReader("", streamReader{bytes.NewReader(content)}, int64(len(content)))
+	if err != nil {
+		t.Fatalf("Users.UploadVideoFromReader returned unexpected error: %v", err)
+	}
+
+	if video.URI != "/videos/1" {
+		t.Errorf("Users.UploadVideoFromReader returned %+v", video)
+	}
+
+	if !bytes.Equal(tus.data, content) {
+		t.Errorf("Uploaded data is %q, want %q", tus.data, content)
+	}
+}
+
+func TestUsersService_UploadVideoFromReader_fileUploader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.Uploader = fileUploader{}
+
+	_, _, err := client.Users.UploadVideoFromReader("", bytes.NewReader(nil), 0)
+	if err == nil {
+		t.Error("Users.UploadVideoFromReader with a file-only uploader returned no error")
+	}
+}
+
+// fileUploader only implements Uploader.
+type fileUploader struct{}
+
+func (fileUploader) UploadFromFile(c *Client, uploadURL string, f *os.File) error {
+	return nil
+}
+
+func TestVideosService_ReplaceFileFromReader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	tus := &tusServer{t: t, size: int64(len(content))}
+	mux.Handle("/upload", tus)
+
+	mux.HandleFunc("/videos/1/versions", func(w http.ResponseWriter, r *http.Request) {
+		v := &UploadVersionRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		want := &UploadVersionRequest{FileName: "new.mp4", Upload: &Upload{Approach: "tus", Size: int64(len(content))}}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Videos.ReplaceFileFromReader body is %+v, want %+v", v, want)
+		}
+
+		fmt.Fprintf(w, `{"uri": "/videos/1/versions/2", "upload": {"approach": "tus", "upload_link": "%s/upload"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/videos/1/versions/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uri": "/videos/1/versions/2", "active": true}`)
+	})
+
+	version, _, err := client.Videos.ReplaceFileFromReader(1, "new.mp4", bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("Videos.ReplaceFileFromReader returned unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(tus.data, content) {
+		t.Errorf("Uploaded data is %q, want %q", tus.data, content)
+	}
+
+	want := &Version{URI: "/videos/1/versions/2", Active: true}
+	if !reflect.DeepEqual(version, want) {
+		t.Errorf("Videos.ReplaceFileFromReader returned %+v, want %+v", version, want)
+	}
+}
+
+func TestVideosService_ReplaceFileFromReader_noUploadLink(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/versions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1/versions/2"}`)
+	})
+
+	_, _, err := client.Videos.ReplaceFileFromReader(1, "new.mp4", bytes.NewReader([]byte("0123456789")), 10)
+	if err == nil {
+		t.Error("Videos.ReplaceFileFromReader returned no error for a version without upload link")
+	}
+}
+
+func TestUsersService_UploadVideoFromReader_noUploadLink(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1", "upload": {"approach": "tus"}}`)
+	})
+
+	_, _, err := client.Users.UploadVideoFromReader("", bytes.NewReader([]byte("0123456789")), 10)
+	if err == nil {
+		t.Error("Users.UploadVideoFromReader returned no error for a video without upload link")
+	}
+}
+
+func TestUsersService_UploadVideo_metadata(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := []byte("0123456789")
+	mux.Handle("/upload", &tusServer{t: t, size: int64(len(content))})
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		v := &UploadVideoRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+		want := &UploadVideoRequest{
+			Name:        "video.mp4",
+			Description: "Description",
+			Embed:       &EmbedRequest{PlayBar: true},
+			Upload:      &Upload{Approach: "tus", Size: int64(len(content))},
+		}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Users.UploadVideo body is %+v, want %+v", v, want)
+		}
+
+		fmt.Fprintf(w, `{"uri": "/videos/1", "upload": {"approach": "tus", "upload_link": "%s/upload"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1"}`)
+	})
+
+	_, _, err := client.Users.UploadVideo("", testFile(t, content), OptMetadata{
+		Description: "Description",
+		Embed:       &EmbedRequest{PlayBar: true},
+	})
+	if err != nil {
+		t.Fatalf("Users.UploadVideo returned unexpected error: %v", err)
+	}
+}
@@ -1,7 +1,195 @@
 package vimeo
 
-import "os"
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+)
 
+// Uploader uploads the contents of a file to the upload link issued by Vimeo.
 type Uploader interface {
 	UploadFromFile(c *Client, uploadURL string, f *os.File) error
 }
+
+// ContextUploader is an Uploader which can be canceled through a context.
+// If the configured Uploader implements it, UploadFromFileWithContext is used
+// in place of UploadFromFile and receives the context of the API call.
+type ContextUploader interface {
+	Uploader
+	UploadFromFileWithContext(ctx context.Context, c *Client, uploadURL string, f *os.File) error
+}
+
+// ReaderUploader is an Uploader which can upload from any io.Reader.
+// The configured Uploader must implement it to upload from a reader
+// rather than a file. If r also implements io.ReaderAt, the upload
+// may be resumed at any offset.
+type ReaderUploader interface {
+	Uploader
+	UploadFromReader(ctx context.Context, c *Client, uploadURL string, r io.Reader, size int64) error
+}
+
+// UploadOption is an optional argument to an upload.
+type UploadOption interface {
+	apply(o *uploadOptions)
+}
+
+// OptMetadata is an optional argument to an upload, holding the metadata sent in the
+// request creating the video. The Upload field is set by the upload, and the Name
+// of an upload from a file defaults to the base name of the file.
+type OptMetadata UploadVideoRequest
+
+func (o OptMetadata) apply(u *uploadOptions) {
+	metadata := UploadVideoRequest(o)
+	u.metadata = &metadata
+}
+
+// uploadOptions holds the options of an upload, starting from the Config of the client.
+type uploadOptions struct {
+	progress  ProgressFunc
+	bandwidth *Bandwidth
+
+	metadata *UploadVideoRequest
+
+	verify   *OptVerify
+	checksum *checksum
+
+	store      SessionStore
+	sessionKey string
+	session    *UploadSession
+}
+
+type uploadOptionsKey struct{}
+
+func (c *Client) uploadOptions(opt []UploadOption) *uploadOptions {
+	o := &uploadOptions{}
+	if c.Config != nil {
+		o.progress = c.Config.Progress
+		o.bandwidth = c.Config.Bandwidth
+		o.store = c.Config.SessionStore
+	}
+
+	for _, item := range opt {
+		item.apply(o)
+	}
+
+	if o.verify != nil {
+		o.checksum = newChecksum()
+	}
+
+	return o
+}
+
+// withUploadOptions returns a context carrying the options of an upload to the Uploader.
+func withUploadOptions(ctx context.Context, o *uploadOptions) context.Context {
+	return context.WithValue(ctx, uploadOptionsKey{}, o)
+}
+
+// uploadOptionsFrom returns the options of the upload carried by ctx,
+// or those of the client when the Uploader is called directly.
+func uploadOptionsFrom(ctx context.Context, c *Client) *uploadOptions {
+	if o, ok := ctx.Value(uploadOptionsKey{}).(*uploadOptions); ok {
+		return o
+	}
+	return c.uploadOptions(nil)
+}
+
+// videoRequest returns the request creating the video of the upload.
+func (o *uploadOptions) videoRequest(name string) *UploadVideoRequest {
+	r := &UploadVideoRequest{}
+	if o.metadata != nil {
+		*r = *o.metadata
+	}
+
+	if r.Name == "" {
+		r.Name = name
+	}
+
+	return r
+}
+
+// createFunc creates the video or version of an upload and returns its URI and upload link.
+type createFunc func(ctx context.Context) (uri string, uploadLink string, err error)
+
+// uploadFromReader uploads size bytes of r to the target, such as me/videos, and returns
+// the URI of the created video or version. The upload resumes the recorded session
+// of a previous upload if any, or is created with the create function.
+func uploadFromReader(ctx context.Context, c *Client, opts *uploadOptions, target string, r io.Reader, size int64, create createFunc) (string, error) {
+	if c.Config.Uploader == nil {
+		return "", errors.New("uploader can't be nil if you need upload video")
+	}
+
+	file, isFile := r.(*os.File)
+	readerUploader, isReaderUploader := c.Config.Uploader.(ReaderUploader)
+	if !isFile && !isReaderUploader {
+		return "", errors.New("uploader must implement ReaderUploader to upload from a reader")
+	}
+
+	var uri, uploadLink string
+	session, err := opts.resumeSession(ctx, c, target, size)
+	if err != nil {
+		return "", err
+	}
+
+	if session != nil {
+		uri, uploadLink = session.VideoURI, session.UploadLink
+
+		c.log(ctx, "vimeo: upload resumed",
+			slog.String("video", uri),
+			slog.Int64("size", size),
+			slog.Int64("offset", session.Offset),
+		)
+	} else {
+		if err := checkQuota(ctx, c, target, size); err != nil {
+			return "", err
+		}
+
+		uri, uploadLink, err = create(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		if err := opts.newSession(ctx, target, size, uri, uploadLink); err != nil {
+			return "", err
+		}
+
+		c.log(ctx, "vimeo: upload started",
+			slog.String("video", uri),
+			slog.Int64("size", size),
+		)
+	}
+	start := time.Now()
+
+	ctx = withUploadOptions(ctx, opts)
+	switch uploader := c.Config.Uploader.(type) {
+	case ContextUploader:
+		if isFile {
+			err = uploader.UploadFromFileWithContext(ctx, c, uploadLink, file)
+		} else {
+			err = readerUploader.UploadFromReader(ctx, c, uploadLink, r, size)
+		}
+	default:
+		if isFile {
+			err = uploader.UploadFromFile(c, uploadLink, file)
+		} else {
+			err = readerUploader.UploadFromReader(ctx, c, uploadLink, r, size)
+		}
+	}
+	if err != nil {
+		c.log(ctx, "vimeo: upload failed",
+			slog.String("video", uri),
+			slog.Duration("duration", time.Since(start)),
+			slog.String("error", c.redactError(err, uploadLink)),
+		)
+		return "", err
+	}
+
+	c.log(ctx, "vimeo: upload finished",
+		slog.String("video", uri),
+		slog.Duration("duration", time.Since(start)),
+	)
+
+	return uri, opts.closeSession(ctx)
+}
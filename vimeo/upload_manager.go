@@ -0,0 +1,233 @@
+package vimeo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sync"
+)
+
+// UploadJob is a video uploaded by an UploadManager.
+type UploadJob struct {
+	// File is the video to upload. If File is nil, Size bytes are read from Reader.
+	File   *os.File
+	Reader io.Reader
+	Size   int64
+
+	// UserID is the user owning the video. The empty string means the authenticated user.
+	UserID string
+
+	// Metadata, if not nil, is applied to the video once uploaded.
+	Metadata *VideoRequest
+
+	// Verify computes the checksum of the upload and verifies it, as OptVerify.
+	Verify bool
+
+	// Options are passed to the upload.
+	Options []UploadOption
+}
+
+// UploadResult is the outcome of an UploadJob.
+type UploadResult struct {
+	Job *UploadJob
+
+	// Video is the uploaded video, with the Metadata applied. It may be set along
+	// with Err when the video was uploaded, but its verification failed or its tags
+	// or Metadata could not be applied.
+	Video *Video
+	Err   error
+
+	// Checksum holds the checksums of the upload of a job with Verify set,
+	// once the bytes are uploaded.
+	Checksum *Checksum
+}
+
+// BatchProgress is the aggregate progress of the jobs of an UploadManager.
+type BatchProgress struct {
+	// Jobs is the number of jobs started.
+	Jobs int
+
+	// Completed and Failed are the numbers of jobs which are done.
+	Completed int
+	Failed    int
+
+	// BytesSent and TotalBytes sum the progress of the jobs which started.
+	BytesSent  int64
+	TotalBytes int64
+}
+
+// UploadManager uploads videos concurrently with a bounded number of workers.
+// The requests of the workers go through the Client, so they share its retry
+// policy and rate limiter.
+type UploadManager struct {
+	client      *Client
+	concurrency int
+
+	// Progress, if not nil, receives the aggregate progress when a job starts,
+	// reports progress, or is done. The calls are serialized. The progress is
+	// reset by each call of UploadAll or Start, which must not run at the same time.
+	Progress func(BatchProgress)
+
+	mu       sync.Mutex
+	progress BatchProgress
+}
+
+// NewUploadManager returns an UploadManager running up to concurrency uploads at a time.
+func NewUploadManager(c *Client, concurrency int) *UploadManager {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &UploadManager{client: c, concurrency: concurrency}
+}
+
+// Start uploads the jobs received from the channel and sends their result on the
+// returned channel, in the order they complete. The results channel is closed once
+// the jobs channel is closed and every job is done. When ctx is done, the remaining
+// jobs fail with the error of the context; the jobs channel must still be closed.
+func (m *UploadManager) Start(ctx context.Context, jobs <-chan *UploadJob) <-chan *UploadResult {
+	m.reset()
+	results := make(chan *UploadResult)
+
+	var wg sync.WaitGroup
+	for i := 0; i < m.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				results <- m.run(ctx, job)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+// UploadAll uploads the jobs and returns their results in the same order.
+func (m *UploadManager) UploadAll(ctx context.Context, jobs ...*UploadJob) []*UploadResult {
+	m.reset()
+
+	queue := make(chan int, len(jobs))
+	for i := range jobs {
+		queue <- i
+	}
+	close(queue)
+
+	results := make([]*UploadResult, len(jobs))
+
+	var wg sync.WaitGroup
+	for i := 0; i < m.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				results[i] = m.run(ctx, jobs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (m *UploadManager) run(ctx context.Context, job *UploadJob) *UploadResult {
+	m.update(func(p *BatchProgress) { p.Jobs++ })
+
+	result := &UploadResult{Job: job}
+	result.Video, result.Err = m.upload(ctx, job, result)
+
+	m.update(func(p *BatchProgress) {
+		if result.Err != nil {
+			p.Failed++
+		} else {
+			p.Completed++
+		}
+	})
+
+	return result
+}
+
+func (m *UploadManager) upload(ctx context.Context, job *UploadJob, result *UploadResult) (*Video, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	size := job.Size
+	if job.File != nil {
+		stat, err := job.File.Stat()
+		if err != nil {
+			return nil, err
+		}
+		size = stat.Size()
+	} else if job.Reader == nil {
+		return nil, errors.New("the upload job has neither file nor reader")
+	}
+
+	m.update(func(p *BatchProgress) { p.TotalBytes += size })
+
+	// Report the progress of the job to the batch, and to the progress function of the job, if any.
+	var sent int64
+	jobProgress := m.client.uploadOptions(job.Options).progress
+	opt := append(job.Options[:len(job.Options):len(job.Options)], OptProgress(func(p Progress) {
+		m.update(func(batch *BatchProgress) {
+			batch.BytesSent += p.BytesSent - sent
+			sent = p.BytesSent
+		})
+		if jobProgress != nil {
+			jobProgress(p)
+		}
+	}))
+
+	if job.Verify {
+		result.Checksum = &Checksum{}
+		opt = append(opt, OptVerify{Checksum: result.Checksum})
+	}
+
+	var video *Video
+	var err error
+	if job.File != nil {
+		video, _, err = m.client.Users.UploadVideoWithContext(ctx, job.UserID, job.File, opt...)
+	} else {
+		video, _, err = m.client.Users.UploadVideoFromReaderWithContext(ctx, job.UserID, job.Reader, size, opt...)
+	}
+	if err != nil {
+		// The video is returned along with the error when it is uploaded but not verified or tagged.
+		return video, err
+	}
+
+	if job.Metadata == nil {
+		return video, nil
+	}
+
+	edited, _, err := m.client.Videos.EditWithContext(ctx, video.GetID(), job.Metadata)
+	if err != nil {
+		return video, err
+	}
+
+	return edited, nil
+}
+
+// reset clears the aggregate progress of the previous batch.
+func (m *UploadManager) reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.progress = BatchProgress{}
+}
+
+// update changes the aggregate progress and reports it.
+func (m *UploadManager) update(fn func(p *BatchProgress)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fn(&m.progress)
+	if m.Progress != nil {
+		m.Progress(m.progress)
+	}
+}
@@ -0,0 +1,184 @@
+package vimeo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// uploadServer serves video creation, tus uploads and video edits for several videos.
+func uploadServer(t *testing.T, sizes ...int64) map[string]*tusServer {
+	tus := make(map[string]*tusServer)
+	for i, size := range sizes {
+		id := strconv.Itoa(i + 1)
+		tus[id] = &tusServer{t: t, size: size}
+		mux.Handle("/upload/"+id, tus[id])
+	}
+
+	var mu sync.Mutex
+	created := 0
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		created++
+		id := created
+		mu.Unlock()
+
+		fmt.Fprintf(w, `{"uri": "/videos/%d", "upload": {"approach": "tus", "upload_link": "%s/upload/%d"}}`, id, server.URL, id)
+	})
+
+	mux.HandleFunc("/videos/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/videos/")
+		if r.Method == "PATCH" {
+			v := &VideoRequest{}
+			json.NewDecoder(r.Body).Decode(v)
+			fmt.Fprintf(w, `{"uri": "/videos/%s", "name": %q}`, id, v.Name)
+			return
+		}
+		fmt.Fprintf(w, `{"uri": "/videos/%s"}`, id)
+	})
+
+	return tus
+}
+
+func TestUploadManager_UploadAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	tus := uploadServer(t, 100, 100, 100)
+
+	var last BatchProgress
+	manager := NewUploadManager(client, 2)
+	manager.Progress = func(p BatchProgress) {
+		last = p
+	}
+
+	jobs := []*UploadJob{
+		{File: testFile(t, content), Metadata: &VideoRequest{Name: "first"}},
+		{Reader: bytes.NewReader(content), Size: int64(len(content))},
+		{File: testFile(t, content), Metadata: &VideoRequest{Name: "third"}},
+		{},
+	}
+
+	results := manager.UploadAll(context.Background(), jobs...)
+	if len(results) != len(jobs) {
+		t.Fatalf("UploadManager.UploadAll returned %v results, want %v", len(results), len(jobs))
+	}
+
+	for i, result := range results[:3] {
+		if result.Job != jobs[i] {
+			t.Errorf("UploadManager.UploadAll result %v is for job %+v, want %+v", i, result.Job, jobs[i])
+		}
+		if result.Err != nil {
+			t.Errorf("UploadManager.UploadAll result %v has unexpected error: %v", i, result.Err)
+		}
+	}
+
+	names := map[string]bool{}
+	for _, result := range results[:3] {
+		if result.Video != nil {
+			names[result.Video.Name] = true
+		}
+	}
+	if !names["first"] || !names["third"] {
+		t.Errorf("UploadManager.UploadAll applied names %v, want first and third", names)
+	}
+
+	if results[3].Err == nil {
+		t.Error("UploadManager.UploadAll returned no error for a job without file nor reader")
+	}
+
+	for id, s := range tus {
+		if !bytes.Equal(s.data, content) {
+			t.Errorf("Uploaded data of video %v is %q, want %q", id, s.data, content)
+		}
+	}
+
+	want := BatchProgress{Jobs: 4, Completed: 3, Failed: 1, BytesSent: 300, TotalBytes: 300}
+	if last != want {
+		t.Errorf("UploadManager reported progress %+v, want %+v", last, want)
+	}
+
+	manager.UploadAll(context.Background(), &UploadJob{})
+
+	want = BatchProgress{Jobs: 1, Failed: 1}
+	if last != want {
+		t.Errorf("UploadManager reported progress %+v for the next batch, want %+v", last, want)
+	}
+}
+
+func TestUploadManager_Start(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := []byte("0123456789")
+	uploadServer(t, 10, 10)
+
+	jobs := make(chan *UploadJob)
+	results := NewUploadManager(client, 2).Start(context.Background(), jobs)
+
+	go func() {
+		jobs <- &UploadJob{Reader: bytes.NewReader(content), Size: int64(len(content))}
+		jobs <- &UploadJob{Reader: bytes.NewReader(content), Size: int64(len(content))}
+		close(jobs)
+	}()
+
+	n := 0
+	for result := range results {
+		if result.Err != nil {
+			t.Errorf("UploadManager.Start result has unexpected error: %v", result.Err)
+		}
+		n++
+	}
+
+	if n != 2 {
+		t.Errorf("UploadManager.Start returned %v results, want %v", n, 2)
+	}
+}
+
+func TestUploadManager_uploadedWithError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := []byte("0123456789")
+	uploadServer(t, 10)
+
+	mux.HandleFunc("/videos/1/tags", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "bad tag"}`, http.StatusBadRequest)
+	})
+
+	results := NewUploadManager(client, 1).UploadAll(context.Background(), &UploadJob{
+		Reader:  bytes.NewReader(content),
+		Size:    int64(len(content)),
+		Options: []UploadOption{OptMetadata{Tags: []string{"a"}}},
+	})
+
+	var tagsErr *TagsError
+	if !errors.As(results[0].Err, &tagsErr) {
+		t.Errorf("UploadManager.UploadAll returned %v, want *TagsError", results[0].Err)
+	}
+
+	if results[0].Video == nil || results[0].Video.URI != "/videos/1" {
+		t.Errorf("UploadManager.UploadAll returned video %+v, want /videos/1", results[0].Video)
+	}
+}
+
+func TestUploadManager_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := NewUploadManager(client, 1).UploadAll(ctx, &UploadJob{Reader: bytes.NewReader(nil)})
+	if results[0].Err != context.Canceled {
+		t.Errorf("UploadManager.UploadAll returned %v, want %v", results[0].Err, context.Canceled)
+	}
+}
@@ -1,7 +1,9 @@
 package vimeo
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"time"
 )
@@ -26,17 +28,53 @@ type WebSite struct {
 
 // User represents a user.
 type User struct {
-	URI           string     `json:"uri,omitempty"`
-	Name          string     `json:"name,omitempty"`
-	Link          string     `json:"link,omitempty"`
-	Location      string     `json:"location,omitempty"`
-	Bio           string     `json:"bio,omitempty"`
-	CreatedTime   time.Time  `json:"created_time,omitempty"`
-	Account       string     `json:"account,omitempty"`
-	Pictures      *Pictures  `json:"pictures,omitempty"`
-	WebSites      []*WebSite `json:"websites,omitempty"`
-	ContentFilter []string   `json:"content_filter,omitempty"`
-	ResourceKey   string     `json:"resource_key,omitempty"`
+	URI           string       `json:"uri,omitempty"`
+	Name          string       `json:"name,omitempty"`
+	Link          string       `json:"link,omitempty"`
+	Location      string       `json:"location,omitempty"`
+	Bio           string       `json:"bio,omitempty"`
+	CreatedTime   time.Time    `json:"created_time,omitempty"`
+	Account       string       `json:"account,omitempty"`
+	Pictures      *Pictures    `json:"pictures,omitempty"`
+	WebSites      []*WebSite   `json:"websites,omitempty"`
+	ContentFilter []string     `json:"content_filter,omitempty"`
+	ResourceKey   string       `json:"resource_key,omitempty"`
+	UploadQuota   *UploadQuota `json:"upload_quota,omitempty"`
+}
+
+// UploadQuota represents the upload quota of a user.
+type UploadQuota struct {
+	Space    *QuotaSpace    `json:"space,omitempty"`
+	Periodic *QuotaPeriodic `json:"periodic,omitempty"`
+	Lifetime *QuotaUsage    `json:"lifetime,omitempty"`
+	FileSize *QuotaFileSize `json:"filesize,omitempty"`
+}
+
+// QuotaUsage represents the space of an upload quota, in bytes.
+// A zero Max means that the space isn't limited.
+type QuotaUsage struct {
+	Free int64 `json:"free,omitempty"`
+	Max  int64 `json:"max,omitempty"`
+	Used int64 `json:"used,omitempty"`
+}
+
+// QuotaSpace represents the space available to the user, which is
+// the lowest of the periodic and lifetime quotas, as shown by Showing.
+type QuotaSpace struct {
+	QuotaUsage
+	Showing string `json:"showing,omitempty"`
+}
+
+// QuotaPeriodic represents the space of the quota renewed every period.
+type QuotaPeriodic struct {
+	QuotaUsage
+	Period    string    `json:"period,omitempty"`
+	ResetDate time.Time `json:"reset_date,omitempty"`
+}
+
+// QuotaFileSize represents the maximum size of a single uploaded file.
+type QuotaFileSize struct {
+	Max int64 `json:"max,omitempty"`
 }
 
 // UserRequest represents a request to create/edit an user.
@@ -46,13 +84,13 @@ type UserRequest struct {
 	Bio      string `json:"bio,omitempty"`
 }
 
-func listUser(c *Client, url string, opt ...CallOption) ([]*User, *Response, error) {
+func listUser(ctx context.Context, c *Client, url string, opt ...CallOption) ([]*User, *Response, error) {
 	u, err := addOptions(url, opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -73,7 +111,12 @@ func listUser(c *Client, url string, opt ...CallOption) ([]*User, *Response, err
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/users#search_users
 func (s *UsersService) Search(opt ...CallOption) ([]*User, *Response, error) {
-	users, resp, err := listUser(s.client, "users", opt...)
+	return s.SearchWithContext(context.Background(), opt...)
+}
+
+// SearchWithContext method is the same as Search, with the addition of the ability to pass a context.
+func (s *UsersService) SearchWithContext(ctx context.Context, opt ...CallOption) ([]*User, *Response, error) {
+	users, resp, err := listUser(ctx, s.client, "users", opt...)
 
 	return users, resp, err
 }
@@ -83,6 +126,11 @@ func (s *UsersService) Search(opt ...CallOption) ([]*User, *Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/users#get_user
 func (s *UsersService) Get(uid string, opt ...CallOption) (*User, *Response, error) {
+	return s.GetWithContext(context.Background(), uid, opt...)
+}
+
+// GetWithContext method is the same as Get, with the addition of the ability to pass a context.
+func (s *UsersService) GetWithContext(ctx context.Context, uid string, opt ...CallOption) (*User, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me"
@@ -95,7 +143,7 @@ func (s *UsersService) Get(uid string, opt ...CallOption) (*User, *Response, err
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -115,6 +163,11 @@ func (s *UsersService) Get(uid string, opt ...CallOption) (*User, *Response, err
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/users#edit_user
 func (s *UsersService) Edit(uid string, r *UserRequest) (*User, *Response, error) {
+	return s.EditWithContext(context.Background(), uid, r)
+}
+
+// EditWithContext method is the same as Edit, with the addition of the ability to pass a context.
+func (s *UsersService) EditWithContext(ctx context.Context, uid string, r *UserRequest) (*User, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me"
@@ -122,7 +175,7 @@ func (s *UsersService) Edit(uid string, r *UserRequest) (*User, *Response, error
 		u = fmt.Sprintf("users/%s", uid)
 	}
 
-	req, err := s.client.NewRequest("PATCH", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -141,6 +194,11 @@ func (s *UsersService) Edit(uid string, r *UserRequest) (*User, *Response, error
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_appearances
 func (s *UsersService) ListAppearance(uid string, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.ListAppearanceWithContext(context.Background(), uid, opt...)
+}
+
+// ListAppearanceWithContext method is the same as ListAppearance, with the addition of the ability to pass a context.
+func (s *UsersService) ListAppearanceWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/appearances"
@@ -148,7 +206,7 @@ func (s *UsersService) ListAppearance(uid string, opt ...CallOption) ([]*Video,
 		u = fmt.Sprintf("users/%s/appearances", uid)
 	}
 
-	videos, resp, err := listVideo(s.client, u, opt...)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
 
 	return videos, resp, err
 }
@@ -158,6 +216,11 @@ func (s *UsersService) ListAppearance(uid string, opt ...CallOption) ([]*Video,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/categories#get_category_subscriptions
 func (s *UsersService) ListCategory(uid string, opt ...CallOption) ([]*Category, *Response, error) {
+	return s.ListCategoryWithContext(context.Background(), uid, opt...)
+}
+
+// ListCategoryWithContext method is the same as ListCategory, with the addition of the ability to pass a context.
+func (s *UsersService) ListCategoryWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*Category, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/categories"
@@ -165,7 +228,7 @@ func (s *UsersService) ListCategory(uid string, opt ...CallOption) ([]*Category,
 		u = fmt.Sprintf("users/%s/categories", uid)
 	}
 
-	categories, resp, err := listCategory(s.client, u, opt...)
+	categories, resp, err := listCategory(ctx, s.client, u, opt...)
 
 	return categories, resp, err
 }
@@ -175,6 +238,11 @@ func (s *UsersService) ListCategory(uid string, opt ...CallOption) ([]*Category,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/categories#subscribe_to_category
 func (s *UsersService) SubscribeCategory(uid string, cat string) (*Response, error) {
+	return s.SubscribeCategoryWithContext(context.Background(), uid, cat)
+}
+
+// SubscribeCategoryWithContext method is the same as SubscribeCategory, with the addition of the ability to pass a context.
+func (s *UsersService) SubscribeCategoryWithContext(ctx context.Context, uid string, cat string) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/categories/%s", cat)
@@ -182,7 +250,7 @@ func (s *UsersService) SubscribeCategory(uid string, cat string) (*Response, err
 		u = fmt.Sprintf("users/%s/categories/%s", uid, cat)
 	}
 
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +263,11 @@ func (s *UsersService) SubscribeCategory(uid string, cat string) (*Response, err
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/categories#unsubscribe_from_category
 func (s *UsersService) UnsubscribeCategory(uid string, cat string) (*Response, error) {
+	return s.UnsubscribeCategoryWithContext(context.Background(), uid, cat)
+}
+
+// UnsubscribeCategoryWithContext method is the same as UnsubscribeCategory, with the addition of the ability to pass a context.
+func (s *UsersService) UnsubscribeCategoryWithContext(ctx context.Context, uid string, cat string) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/categories/%s", cat)
@@ -202,7 +275,7 @@ func (s *UsersService) UnsubscribeCategory(uid string, cat string) (*Response, e
 		u = fmt.Sprintf("users/%s/categories/%s", uid, cat)
 	}
 
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +288,11 @@ func (s *UsersService) UnsubscribeCategory(uid string, cat string) (*Response, e
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#get_channel_subscriptions
 func (s *UsersService) ListChannel(uid string, opt ...CallOption) ([]*Channel, *Response, error) {
+	return s.ListChannelWithContext(context.Background(), uid, opt...)
+}
+
+// ListChannelWithContext method is the same as ListChannel, with the addition of the ability to pass a context.
+func (s *UsersService) ListChannelWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*Channel, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/channels"
@@ -222,7 +300,7 @@ func (s *UsersService) ListChannel(uid string, opt ...CallOption) ([]*Channel, *
 		u = fmt.Sprintf("users/%s/channels", uid)
 	}
 
-	categories, resp, err := listChannel(s.client, u, opt...)
+	categories, resp, err := listChannel(ctx, s.client, u, opt...)
 
 	return categories, resp, err
 }
@@ -232,6 +310,11 @@ func (s *UsersService) ListChannel(uid string, opt ...CallOption) ([]*Channel, *
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#subscribe_to_channel
 func (s *UsersService) SubscribeChannel(uid string, ch string) (*Response, error) {
+	return s.SubscribeChannelWithContext(context.Background(), uid, ch)
+}
+
+// SubscribeChannelWithContext method is the same as SubscribeChannel, with the addition of the ability to pass a context.
+func (s *UsersService) SubscribeChannelWithContext(ctx context.Context, uid string, ch string) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/channels/%s", ch)
@@ -239,7 +322,7 @@ func (s *UsersService) SubscribeChannel(uid string, ch string) (*Response, error
 		u = fmt.Sprintf("users/%s/channels/%s", uid, ch)
 	}
 
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -252,6 +335,11 @@ func (s *UsersService) SubscribeChannel(uid string, ch string) (*Response, error
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#unsubscribe_from_channel
 func (s *UsersService) UnsubscribeChannel(uid string, ch string) (*Response, error) {
+	return s.UnsubscribeChannelWithContext(context.Background(), uid, ch)
+}
+
+// UnsubscribeChannelWithContext method is the same as UnsubscribeChannel, with the addition of the ability to pass a context.
+func (s *UsersService) UnsubscribeChannelWithContext(ctx context.Context, uid string, ch string) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/channels/%s", ch)
@@ -259,7 +347,7 @@ func (s *UsersService) UnsubscribeChannel(uid string, ch string) (*Response, err
 		u = fmt.Sprintf("users/%s/channels/%s", uid, ch)
 	}
 
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -283,6 +371,11 @@ type Feed struct {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/users#get_feed
 func (s *UsersService) Feed(uid string, opt ...CallOption) ([]*Feed, *Response, error) {
+	return s.FeedWithContext(context.Background(), uid, opt...)
+}
+
+// FeedWithContext method is the same as Feed, with the addition of the ability to pass a context.
+func (s *UsersService) FeedWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*Feed, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/feed"
@@ -295,7 +388,7 @@ func (s *UsersService) Feed(uid string, opt ...CallOption) ([]*Feed, *Response,
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -317,6 +410,11 @@ func (s *UsersService) Feed(uid string, opt ...CallOption) ([]*Feed, *Response,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/users#get_followers
 func (s *UsersService) ListFollower(uid string, opt ...CallOption) ([]*User, *Response, error) {
+	return s.ListFollowerWithContext(context.Background(), uid, opt...)
+}
+
+// ListFollowerWithContext method is the same as ListFollower, with the addition of the ability to pass a context.
+func (s *UsersService) ListFollowerWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*User, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/followers"
@@ -324,7 +422,7 @@ func (s *UsersService) ListFollower(uid string, opt ...CallOption) ([]*User, *Re
 		u = fmt.Sprintf("users/%s/followers", uid)
 	}
 
-	users, resp, err := listUser(s.client, u, opt...)
+	users, resp, err := listUser(ctx, s.client, u, opt...)
 
 	return users, resp, err
 }
@@ -334,6 +432,11 @@ func (s *UsersService) ListFollower(uid string, opt ...CallOption) ([]*User, *Re
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/users#follow_users
 func (s *UsersService) ListFollowed(uid string, opt ...CallOption) ([]*User, *Response, error) {
+	return s.ListFollowedWithContext(context.Background(), uid, opt...)
+}
+
+// ListFollowedWithContext method is the same as ListFollowed, with the addition of the ability to pass a context.
+func (s *UsersService) ListFollowedWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*User, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/following"
@@ -341,7 +444,7 @@ func (s *UsersService) ListFollowed(uid string, opt ...CallOption) ([]*User, *Re
 		u = fmt.Sprintf("users/%s/following", uid)
 	}
 
-	users, resp, err := listUser(s.client, u, opt...)
+	users, resp, err := listUser(ctx, s.client, u, opt...)
 
 	return users, resp, err
 }
@@ -351,6 +454,11 @@ func (s *UsersService) ListFollowed(uid string, opt ...CallOption) ([]*User, *Re
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/users#follow_user
 func (s *UsersService) FollowUser(uid string, fid string) (*Response, error) {
+	return s.FollowUserWithContext(context.Background(), uid, fid)
+}
+
+// FollowUserWithContext method is the same as FollowUser, with the addition of the ability to pass a context.
+func (s *UsersService) FollowUserWithContext(ctx context.Context, uid string, fid string) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/following/%s", fid)
@@ -358,7 +466,7 @@ func (s *UsersService) FollowUser(uid string, fid string) (*Response, error) {
 		u = fmt.Sprintf("users/%s/following/%s", uid, fid)
 	}
 
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -371,6 +479,11 @@ func (s *UsersService) FollowUser(uid string, fid string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/users#unfollow_user
 func (s *UsersService) UnfollowUser(uid string, fid string) (*Response, error) {
+	return s.UnfollowUserWithContext(context.Background(), uid, fid)
+}
+
+// UnfollowUserWithContext method is the same as UnfollowUser, with the addition of the ability to pass a context.
+func (s *UsersService) UnfollowUserWithContext(ctx context.Context, uid string, fid string) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/following/%s", fid)
@@ -378,7 +491,7 @@ func (s *UsersService) UnfollowUser(uid string, fid string) (*Response, error) {
 		u = fmt.Sprintf("users/%s/following/%s", uid, fid)
 	}
 
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -391,6 +504,11 @@ func (s *UsersService) UnfollowUser(uid string, fid string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/groups#get_user_groups
 func (s *UsersService) ListGroup(uid string, opt ...CallOption) ([]*Group, *Response, error) {
+	return s.ListGroupWithContext(context.Background(), uid, opt...)
+}
+
+// ListGroupWithContext method is the same as ListGroup, with the addition of the ability to pass a context.
+func (s *UsersService) ListGroupWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*Group, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/groups"
@@ -398,7 +516,7 @@ func (s *UsersService) ListGroup(uid string, opt ...CallOption) ([]*Group, *Resp
 		u = fmt.Sprintf("users/%s/groups", uid)
 	}
 
-	groups, resp, err := listGroup(s.client, u, opt...)
+	groups, resp, err := listGroup(ctx, s.client, u, opt...)
 
 	return groups, resp, err
 }
@@ -408,6 +526,11 @@ func (s *UsersService) ListGroup(uid string, opt ...CallOption) ([]*Group, *Resp
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/groups#join_group
 func (s *UsersService) JoinGroup(uid string, gid string) (*Response, error) {
+	return s.JoinGroupWithContext(context.Background(), uid, gid)
+}
+
+// JoinGroupWithContext method is the same as JoinGroup, with the addition of the ability to pass a context.
+func (s *UsersService) JoinGroupWithContext(ctx context.Context, uid string, gid string) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/groups/%s", gid)
@@ -415,7 +538,7 @@ func (s *UsersService) JoinGroup(uid string, gid string) (*Response, error) {
 		u = fmt.Sprintf("users/%s/groups/%s", uid, gid)
 	}
 
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -428,6 +551,11 @@ func (s *UsersService) JoinGroup(uid string, gid string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/groups#leave_group
 func (s *UsersService) LeaveGroup(uid string, gid string) (*Response, error) {
+	return s.LeaveGroupWithContext(context.Background(), uid, gid)
+}
+
+// LeaveGroupWithContext method is the same as LeaveGroup, with the addition of the ability to pass a context.
+func (s *UsersService) LeaveGroupWithContext(ctx context.Context, uid string, gid string) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/groups/%s", gid)
@@ -435,7 +563,7 @@ func (s *UsersService) LeaveGroup(uid string, gid string) (*Response, error) {
 		u = fmt.Sprintf("users/%s/groups/%s", uid, gid)
 	}
 
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -448,6 +576,11 @@ func (s *UsersService) LeaveGroup(uid string, gid string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/likes#get_likes
 func (s *UsersService) ListLikedVideo(uid string, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.ListLikedVideoWithContext(context.Background(), uid, opt...)
+}
+
+// ListLikedVideoWithContext method is the same as ListLikedVideo, with the addition of the ability to pass a context.
+func (s *UsersService) ListLikedVideoWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/likes"
@@ -455,7 +588,7 @@ func (s *UsersService) ListLikedVideo(uid string, opt ...CallOption) ([]*Video,
 		u = fmt.Sprintf("users/%s/likes", uid)
 	}
 
-	videos, resp, err := listVideo(s.client, u, opt...)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
 
 	return videos, resp, err
 }
@@ -465,6 +598,11 @@ func (s *UsersService) ListLikedVideo(uid string, opt ...CallOption) ([]*Video,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/likes#like_video
 func (s *UsersService) LikeVideo(uid string, vid int) (*Response, error) {
+	return s.LikeVideoWithContext(context.Background(), uid, vid)
+}
+
+// LikeVideoWithContext method is the same as LikeVideo, with the addition of the ability to pass a context.
+func (s *UsersService) LikeVideoWithContext(ctx context.Context, uid string, vid int) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/likes/%d", vid)
@@ -472,7 +610,7 @@ func (s *UsersService) LikeVideo(uid string, vid int) (*Response, error) {
 		u = fmt.Sprintf("users/%s/likes/%d", uid, vid)
 	}
 
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -485,6 +623,11 @@ func (s *UsersService) LikeVideo(uid string, vid int) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/likes#unlike_video
 func (s *UsersService) UnlikeVideo(uid string, vid int) (*Response, error) {
+	return s.UnlikeVideoWithContext(context.Background(), uid, vid)
+}
+
+// UnlikeVideoWithContext method is the same as UnlikeVideo, with the addition of the ability to pass a context.
+func (s *UsersService) UnlikeVideoWithContext(ctx context.Context, uid string, vid int) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/likes/%d", vid)
@@ -492,7 +635,7 @@ func (s *UsersService) UnlikeVideo(uid string, vid int) (*Response, error) {
 		u = fmt.Sprintf("users/%s/likes/%d", uid, vid)
 	}
 
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -505,6 +648,11 @@ func (s *UsersService) UnlikeVideo(uid string, vid int) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/users#delete_picture
 func (s *UsersService) RemovePortrait(uid string, pid string) (*Response, error) {
+	return s.RemovePortraitWithContext(context.Background(), uid, pid)
+}
+
+// RemovePortraitWithContext method is the same as RemovePortrait, with the addition of the ability to pass a context.
+func (s *UsersService) RemovePortraitWithContext(ctx context.Context, uid string, pid string) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/pictures/%s", pid)
@@ -512,7 +660,7 @@ func (s *UsersService) RemovePortrait(uid string, pid string) (*Response, error)
 		u = fmt.Sprintf("users/%s/pictures/%s", uid, pid)
 	}
 
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -525,6 +673,11 @@ func (s *UsersService) RemovePortrait(uid string, pid string) (*Response, error)
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_videos
 func (s *UsersService) ListVideo(uid string, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.ListVideoWithContext(context.Background(), uid, opt...)
+}
+
+// ListVideoWithContext method is the same as ListVideo, with the addition of the ability to pass a context.
+func (s *UsersService) ListVideoWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/videos"
@@ -532,7 +685,7 @@ func (s *UsersService) ListVideo(uid string, opt ...CallOption) ([]*Video, *Resp
 		u = fmt.Sprintf("users/%s/videos", uid)
 	}
 
-	videos, resp, err := listVideo(s.client, u, opt...)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
 
 	return videos, resp, err
 }
@@ -542,6 +695,11 @@ func (s *UsersService) ListVideo(uid string, opt ...CallOption) ([]*Video, *Resp
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#check_if_user_owns_video
 func (s *UsersService) GetVideo(uid string, vid int, opt ...CallOption) (*Video, *Response, error) {
+	return s.GetVideoWithContext(context.Background(), uid, vid, opt...)
+}
+
+// GetVideoWithContext method is the same as GetVideo, with the addition of the ability to pass a context.
+func (s *UsersService) GetVideoWithContext(ctx context.Context, uid string, vid int, opt ...CallOption) (*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/videos/%d", vid)
@@ -549,7 +707,7 @@ func (s *UsersService) GetVideo(uid string, vid int, opt ...CallOption) (*Video,
 		u = fmt.Sprintf("users/%s/videos/%d", uid, vid)
 	}
 
-	video, resp, err := getVideo(s.client, u, opt...)
+	video, resp, err := getVideo(ctx, s.client, u, opt...)
 
 	return video, resp, err
 }
@@ -558,7 +716,12 @@ func (s *UsersService) GetVideo(uid string, vid int, opt ...CallOption) (*Video,
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#upload_video
-func (s *UsersService) UploadVideo(uid string, file *os.File) (*Video, *Response, error) {
+func (s *UsersService) UploadVideo(uid string, file *os.File, opt ...UploadOption) (*Video, *Response, error) {
+	return s.UploadVideoWithContext(context.Background(), uid, file, opt...)
+}
+
+// UploadVideoWithContext method is the same as UploadVideo, with the addition of the ability to pass a context.
+func (s *UsersService) UploadVideoWithContext(ctx context.Context, uid string, file *os.File, opt ...UploadOption) (*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/videos"
@@ -566,7 +729,31 @@ func (s *UsersService) UploadVideo(uid string, file *os.File) (*Video, *Response
 		u = fmt.Sprintf("users/%s/videos", uid)
 	}
 
-	video, resp, err := uploadVideo(s.client, "POST", u, file)
+	video, resp, err := uploadVideo(ctx, s.client, "POST", u, file, opt...)
+
+	return video, resp, err
+}
+
+// UploadVideoFromReader method is the same as UploadVideo, but reads size bytes of the video from r.
+// If r implements io.ReaderAt, a failed upload is resumed at the offset received by Vimeo,
+// otherwise the current chunk is kept in memory to be sent again.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/videos#upload_video
+func (s *UsersService) UploadVideoFromReader(uid string, r io.Reader, size int64, opt ...UploadOption) (*Video, *Response, error) {
+	return s.UploadVideoFromReaderWithContext(context.Background(), uid, r, size, opt...)
+}
+
+// UploadVideoFromReaderWithContext method is the same as UploadVideoFromReader, with the addition of the ability to pass a context.
+func (s *UsersService) UploadVideoFromReaderWithContext(ctx context.Context, uid string, r io.Reader, size int64, opt ...UploadOption) (*Video, *Response, error) {
+	var u string
+	if uid == "" {
+		u = "me/videos"
+	} else {
+		u = fmt.Sprintf("users/%s/videos", uid)
+	}
+
+	video, resp, err := uploadVideoFromReader(ctx, s.client, "POST", u, "", r, size, opt...)
 
 	return video, resp, err
 }
@@ -575,7 +762,12 @@ func (s *UsersService) UploadVideo(uid string, file *os.File) (*Video, *Response
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#upload_video
-func (s *UsersService) UploadVideoByURL(uid string, videoURL string) (*Video, *Response, error) {
+func (s *UsersService) UploadVideoByURL(uid string, videoURL string, opt ...UploadOption) (*Video, *Response, error) {
+	return s.UploadVideoByURLWithContext(context.Background(), uid, videoURL, opt...)
+}
+
+// UploadVideoByURLWithContext method is the same as UploadVideoByURL, with the addition of the ability to pass a context.
+func (s *UsersService) UploadVideoByURLWithContext(ctx context.Context, uid string, videoURL string, opt ...UploadOption) (*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/videos"
@@ -583,7 +775,7 @@ func (s *UsersService) UploadVideoByURL(uid string, videoURL string) (*Video, *R
 		u = fmt.Sprintf("users/%s/videos", uid)
 	}
 
-	video, resp, err := uploadVideoByURL(s.client, u, videoURL)
+	video, resp, err := uploadVideoByURL(ctx, s.client, u, videoURL, opt...)
 
 	return video, resp, err
 }
@@ -593,6 +785,11 @@ func (s *UsersService) UploadVideoByURL(uid string, videoURL string) (*Video, *R
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/watch-later-queue#get_watch_later_queue
 func (s *UsersService) WatchLaterListVideo(uid string, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.WatchLaterListVideoWithContext(context.Background(), uid, opt...)
+}
+
+// WatchLaterListVideoWithContext method is the same as WatchLaterListVideo, with the addition of the ability to pass a context.
+func (s *UsersService) WatchLaterListVideoWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/watchlater"
@@ -600,7 +797,7 @@ func (s *UsersService) WatchLaterListVideo(uid string, opt ...CallOption) ([]*Vi
 		u = fmt.Sprintf("users/%s/watchlater", uid)
 	}
 
-	videos, resp, err := listVideo(s.client, u, opt...)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
 
 	return videos, resp, err
 }
@@ -610,6 +807,11 @@ func (s *UsersService) WatchLaterListVideo(uid string, opt ...CallOption) ([]*Vi
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/watch-later-queue#check_watch_later_queue
 func (s *UsersService) WatchLaterGetVideo(uid string, vid int) (*Video, *Response, error) {
+	return s.WatchLaterGetVideoWithContext(context.Background(), uid, vid)
+}
+
+// WatchLaterGetVideoWithContext method is the same as WatchLaterGetVideo, with the addition of the ability to pass a context.
+func (s *UsersService) WatchLaterGetVideoWithContext(ctx context.Context, uid string, vid int) (*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/watchlater/%d", vid)
@@ -617,7 +819,7 @@ func (s *UsersService) WatchLaterGetVideo(uid string, vid int) (*Video, *Respons
 		u = fmt.Sprintf("users/%s/watchlater/%d", uid, vid)
 	}
 
-	video, resp, err := getVideo(s.client, u)
+	video, resp, err := getVideo(ctx, s.client, u)
 
 	return video, resp, err
 }
@@ -627,6 +829,11 @@ func (s *UsersService) WatchLaterGetVideo(uid string, vid int) (*Video, *Respons
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/watch-later-queue#add_video_to_watch_later
 func (s *UsersService) WatchLaterAddVideo(uid string, vid int) (*Response, error) {
+	return s.WatchLaterAddVideoWithContext(context.Background(), uid, vid)
+}
+
+// WatchLaterAddVideoWithContext method is the same as WatchLaterAddVideo, with the addition of the ability to pass a context.
+func (s *UsersService) WatchLaterAddVideoWithContext(ctx context.Context, uid string, vid int) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/watchlater/%d", vid)
@@ -634,7 +841,7 @@ func (s *UsersService) WatchLaterAddVideo(uid string, vid int) (*Response, error
 		u = fmt.Sprintf("users/%s/watchlater/%d", uid, vid)
 	}
 
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -647,6 +854,11 @@ func (s *UsersService) WatchLaterAddVideo(uid string, vid int) (*Response, error
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/watch-later-queue#delete_video_from_watch_later
 func (s *UsersService) WatchLaterDeleteVideo(uid string, vid int) (*Response, error) {
+	return s.WatchLaterDeleteVideoWithContext(context.Background(), uid, vid)
+}
+
+// WatchLaterDeleteVideoWithContext method is the same as WatchLaterDeleteVideo, with the addition of the ability to pass a context.
+func (s *UsersService) WatchLaterDeleteVideoWithContext(ctx context.Context, uid string, vid int) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/watchlater/%d", vid)
@@ -654,7 +866,7 @@ func (s *UsersService) WatchLaterDeleteVideo(uid string, vid int) (*Response, er
 		u = fmt.Sprintf("users/%s/watchlater/%d", uid, vid)
 	}
 
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,9 @@
 package vimeo
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -23,54 +25,89 @@ type Privacy struct {
 	Add      bool   `json:"add"`
 }
 
-// Album represents a album.
+// Album represents a album, called showcase by Vimeo.
 type Album struct {
-	URI          string    `json:"uri,omitempty"`
-	Name         string    `json:"name,omitempty"`
-	Description  string    `json:"description,omitempty"`
-	Link         string    `json:"link,omitempty"`
-	Duration     int       `json:"duration,omitempty"`
-	CreatedTime  time.Time `json:"created_time,omitempty"`
-	ModifiedTime time.Time `json:"modified_time,omitempty"`
-	User         *User     `json:"user,omitempty"`
-	Pictures     *Pictures `json:"pictures,omitempty"`
-	Privacy      *Privacy  `json:"privacy,omitempty"`
+	URI             string      `json:"uri,omitempty"`
+	Name            string      `json:"name,omitempty"`
+	Description     string      `json:"description,omitempty"`
+	Link            string      `json:"link,omitempty"`
+	Duration        int         `json:"duration,omitempty"`
+	CreatedTime     time.Time   `json:"created_time,omitempty"`
+	ModifiedTime    time.Time   `json:"modified_time,omitempty"`
+	User            *User       `json:"user,omitempty"`
+	Pictures        *Pictures   `json:"pictures,omitempty"`
+	Privacy         *Privacy    `json:"privacy,omitempty"`
+	Sort            string      `json:"sort,omitempty"`
+	Layout          string      `json:"layout,omitempty"`
+	Theme           string      `json:"theme,omitempty"`
+	BrandColor      string      `json:"brand_color,omitempty"`
+	HideNav         bool        `json:"hide_nav,omitempty"`
+	HideUpcoming    bool        `json:"hide_upcoming,omitempty"`
+	HideVimeoLogo   bool        `json:"hide_vimeo_logo,omitempty"`
+	ReviewMode      bool        `json:"review_mode,omitempty"`
+	Domain          string      `json:"domain,omitempty"`
+	UseCustomDomain bool        `json:"use_custom_domain,omitempty"`
+	URL             string      `json:"url,omitempty"`
+	Embed           *AlbumEmbed `json:"embed,omitempty"`
+	CustomLogo      *Pictures   `json:"custom_logo,omitempty"`
+}
+
+// AlbumEmbed internal object provides access to the embed settings of an album.
+type AlbumEmbed struct {
+	HTML       string `json:"html,omitempty"`
+	BrandColor bool   `json:"brand_color,omitempty"`
+	Logo       bool   `json:"logo,omitempty"`
 }
 
 // AlbumRequest represents a request to create/edit an album.
+// The settings left nil are not changed.
 type AlbumRequest struct {
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
-	Privacy     string `json:"privacy,omitempty"`
-	Password    string `json:"password,omitempty"`
-	Sort        string `json:"sort,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	Privacy         string `json:"privacy,omitempty"`
+	Password        string `json:"password,omitempty"`
+	Sort            string `json:"sort,omitempty"`
+	Layout          string `json:"layout,omitempty"`
+	Theme           string `json:"theme,omitempty"`
+	BrandColor      string `json:"brand_color,omitempty"`
+	HideNav         *bool  `json:"hide_nav,omitempty"`
+	HideUpcoming    *bool  `json:"hide_upcoming,omitempty"`
+	HideVimeoLogo   *bool  `json:"hide_vimeo_logo,omitempty"`
+	ReviewMode      *bool  `json:"review_mode,omitempty"`
+	Domain          string `json:"domain,omitempty"`
+	UseCustomDomain *bool  `json:"use_custom_domain,omitempty"`
+	URL             string `json:"url,omitempty"`
+	EmbedBrandColor *bool  `json:"embed_brand_color,omitempty"`
+	EmbedCustomLogo *bool  `json:"embed_custom_logo,omitempty"`
 }
 
-// ListAlbum method gets all the albums from the specified user's account.
-// Passing the empty string will edit authenticated user.
-//
-// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#get_albums
-func (s *UsersService) ListAlbum(uid string, opt ...CallOption) ([]*Album, *Response, error) {
-	var u string
+// AlbumThumbnailRequest represents a request to set the thumbnail of an album from a frame of a video.
+type AlbumThumbnailRequest struct {
+	TimeCode float64 `json:"time_code,omitempty"`
+}
+
+// albumURL returns the URL of an album of the specified user, followed by path.
+func albumURL(uid string, ab string, path string) string {
 	if uid == "" {
-		u = "me/albums"
-	} else {
-		u = fmt.Sprintf("users/%s/albums", uid)
+		return fmt.Sprintf("me/albums/%s%s", ab, path)
 	}
+	return fmt.Sprintf("users/%s/albums/%s%s", uid, ab, path)
+}
 
-	u, err := addOptions(u, opt...)
+func listAlbum(ctx context.Context, c *Client, url string, opt ...CallOption) ([]*Album, *Response, error) {
+	u, err := addOptions(url, opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	albums := &dataListAlbum{}
 
-	resp, err := s.client.Do(req, albums)
+	resp, err := c.Do(req, albums)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -80,11 +117,37 @@ func (s *UsersService) ListAlbum(uid string, opt ...CallOption) ([]*Album, *Resp
 	return albums.Data, resp, err
 }
 
+// ListAlbum method gets all the albums from the specified user's account.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#get_albums
+func (s *UsersService) ListAlbum(uid string, opt ...CallOption) ([]*Album, *Response, error) {
+	return s.ListAlbumWithContext(context.Background(), uid, opt...)
+}
+
+// ListAlbumWithContext method is the same as ListAlbum, with the addition of the ability to pass a context.
+func (s *UsersService) ListAlbumWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*Album, *Response, error) {
+	var u string
+	if uid == "" {
+		u = "me/albums"
+	} else {
+		u = fmt.Sprintf("users/%s/albums", uid)
+	}
+	albums, resp, err := listAlbum(ctx, s.client, u, opt...)
+
+	return albums, resp, err
+}
+
 // CreateAlbum method creates a new album for the specified user.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/albums#create_album
 func (s *UsersService) CreateAlbum(uid string, r *AlbumRequest) (*Album, *Response, error) {
+	return s.CreateAlbumWithContext(context.Background(), uid, r)
+}
+
+// CreateAlbumWithContext method is the same as CreateAlbum, with the addition of the ability to pass a context.
+func (s *UsersService) CreateAlbumWithContext(ctx context.Context, uid string, r *AlbumRequest) (*Album, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/albums"
@@ -92,7 +155,7 @@ func (s *UsersService) CreateAlbum(uid string, r *AlbumRequest) (*Album, *Respon
 		u = fmt.Sprintf("users/%s/albums", uid)
 	}
 
-	req, err := s.client.NewRequest("POST", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -111,6 +174,11 @@ func (s *UsersService) CreateAlbum(uid string, r *AlbumRequest) (*Album, *Respon
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/albums#get_album
 func (s *UsersService) GetAlbum(uid string, ab string, opt ...CallOption) (*Album, *Response, error) {
+	return s.GetAlbumWithContext(context.Background(), uid, ab, opt...)
+}
+
+// GetAlbumWithContext method is the same as GetAlbum, with the addition of the ability to pass a context.
+func (s *UsersService) GetAlbumWithContext(ctx context.Context, uid string, ab string, opt ...CallOption) (*Album, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/albums/%s", ab)
@@ -123,7 +191,7 @@ func (s *UsersService) GetAlbum(uid string, ab string, opt ...CallOption) (*Albu
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -143,6 +211,11 @@ func (s *UsersService) GetAlbum(uid string, ab string, opt ...CallOption) (*Albu
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/albums#edit_album
 func (s *UsersService) EditAlbum(uid string, ab string, r *AlbumRequest) (*Album, *Response, error) {
+	return s.EditAlbumWithContext(context.Background(), uid, ab, r)
+}
+
+// EditAlbumWithContext method is the same as EditAlbum, with the addition of the ability to pass a context.
+func (s *UsersService) EditAlbumWithContext(ctx context.Context, uid string, ab string, r *AlbumRequest) (*Album, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/albums/%s", ab)
@@ -150,7 +223,7 @@ func (s *UsersService) EditAlbum(uid string, ab string, r *AlbumRequest) (*Album
 		u = fmt.Sprintf("users/%s/albums/%s", uid, ab)
 	}
 
-	req, err := s.client.NewRequest("PATCH", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -169,6 +242,11 @@ func (s *UsersService) EditAlbum(uid string, ab string, r *AlbumRequest) (*Album
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/albums#delete_album
 func (s *UsersService) DeleteAlbum(uid string, ab string) (*Response, error) {
+	return s.DeleteAlbumWithContext(context.Background(), uid, ab)
+}
+
+// DeleteAlbumWithContext method is the same as DeleteAlbum, with the addition of the ability to pass a context.
+func (s *UsersService) DeleteAlbumWithContext(ctx context.Context, uid string, ab string) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/albums/%s", ab)
@@ -176,7 +254,7 @@ func (s *UsersService) DeleteAlbum(uid string, ab string) (*Response, error) {
 		u = fmt.Sprintf("users/%s/albums/%s", uid, ab)
 	}
 
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -189,13 +267,18 @@ func (s *UsersService) DeleteAlbum(uid string, ab string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/albums#get_album_videos
 func (s *UsersService) AlbumListVideo(uid string, ab string, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.AlbumListVideoWithContext(context.Background(), uid, ab, opt...)
+}
+
+// AlbumListVideoWithContext method is the same as AlbumListVideo, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumListVideoWithContext(ctx context.Context, uid string, ab string, opt ...CallOption) ([]*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/albums/%s/videos", ab)
 	} else {
 		u = fmt.Sprintf("users/%s/albums/%s/videos", uid, ab)
 	}
-	videos, resp, err := listVideo(s.client, u, opt...)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
 
 	return videos, resp, err
 }
@@ -205,13 +288,18 @@ func (s *UsersService) AlbumListVideo(uid string, ab string, opt ...CallOption)
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/albums#get_album_video
 func (s *UsersService) AlbumGetVideo(uid string, ab string, vid int, opt ...CallOption) (*Video, *Response, error) {
+	return s.AlbumGetVideoWithContext(context.Background(), uid, ab, vid, opt...)
+}
+
+// AlbumGetVideoWithContext method is the same as AlbumGetVideo, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumGetVideoWithContext(ctx context.Context, uid string, ab string, vid int, opt ...CallOption) (*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/albums/%s/videos/%d", ab, vid)
 	} else {
 		u = fmt.Sprintf("users/%s/albums/%s/videos/%d", uid, ab, vid)
 	}
-	video, resp, err := getVideo(s.client, u, opt...)
+	video, resp, err := getVideo(ctx, s.client, u, opt...)
 
 	return video, resp, err
 }
@@ -221,13 +309,18 @@ func (s *UsersService) AlbumGetVideo(uid string, ab string, vid int, opt ...Call
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/albums#add_video_to_album
 func (s *UsersService) AlbumAddVideo(uid string, ab string, vid int) (*Video, *Response, error) {
+	return s.AlbumAddVideoWithContext(context.Background(), uid, ab, vid)
+}
+
+// AlbumAddVideoWithContext method is the same as AlbumAddVideo, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumAddVideoWithContext(ctx context.Context, uid string, ab string, vid int) (*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/albums/%s/videos/%d", ab, vid)
 	} else {
 		u = fmt.Sprintf("users/%s/albums/%s/videos/%d", uid, ab, vid)
 	}
-	video, resp, err := addVideo(s.client, u)
+	video, resp, err := addVideo(ctx, s.client, u)
 
 	return video, resp, err
 }
@@ -237,6 +330,11 @@ func (s *UsersService) AlbumAddVideo(uid string, ab string, vid int) (*Video, *R
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/albums#remove_video_from_album
 func (s *UsersService) AlbumDeleteVideo(uid string, ab string, vid int) (*Response, error) {
+	return s.AlbumDeleteVideoWithContext(context.Background(), uid, ab, vid)
+}
+
+// AlbumDeleteVideoWithContext method is the same as AlbumDeleteVideo, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumDeleteVideoWithContext(ctx context.Context, uid string, ab string, vid int) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/albums/%s/videos/%d", ab, vid)
@@ -244,7 +342,79 @@ func (s *UsersService) AlbumDeleteVideo(uid string, ab string, vid int) (*Respon
 		u = fmt.Sprintf("users/%s/albums/%s/videos/%d", uid, ab, vid)
 	}
 
-	resp, err := deleteVideo(s.client, u)
+	resp, err := deleteVideo(ctx, s.client, u)
 
 	return resp, err
 }
+
+// AlbumSetVideos method replaces all the videos of the specified album with the given ones.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/showcases#replace_videos_in_showcase
+func (s *UsersService) AlbumSetVideos(uid string, ab string, vids []int) (*Response, error) {
+	return s.AlbumSetVideosWithContext(context.Background(), uid, ab, vids)
+}
+
+// AlbumSetVideosWithContext method is the same as AlbumSetVideos, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumSetVideosWithContext(ctx context.Context, uid string, ab string, vids []int) (*Response, error) {
+	body := struct {
+		Videos string `json:"videos"`
+	}{strings.Join(videoURIs(vids), ",")}
+
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", albumURL(uid, ab, "/videos"), body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// AlbumSetFeaturedVideo method sets the featured video of the specified album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/showcases#set_showcase_featured_video
+func (s *UsersService) AlbumSetFeaturedVideo(uid string, ab string, vid int) (*Album, *Response, error) {
+	return s.AlbumSetFeaturedVideoWithContext(context.Background(), uid, ab, vid)
+}
+
+// AlbumSetFeaturedVideoWithContext method is the same as AlbumSetFeaturedVideo, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumSetFeaturedVideoWithContext(ctx context.Context, uid string, ab string, vid int) (*Album, *Response, error) {
+	u := albumURL(uid, ab, fmt.Sprintf("/videos/%d/set_featured_video", vid))
+	req, err := s.client.NewRequestWithContext(ctx, "POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	album := &Album{}
+	resp, err := s.client.Do(req, album)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return album, resp, nil
+}
+
+// AlbumSetThumbnail method sets the thumbnail of the specified album to a frame of one of its videos.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/showcases#set_video_as_showcase_thumbnail
+func (s *UsersService) AlbumSetThumbnail(uid string, ab string, vid int, r *AlbumThumbnailRequest) (*Album, *Response, error) {
+	return s.AlbumSetThumbnailWithContext(context.Background(), uid, ab, vid, r)
+}
+
+// AlbumSetThumbnailWithContext method is the same as AlbumSetThumbnail, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumSetThumbnailWithContext(ctx context.Context, uid string, ab string, vid int, r *AlbumThumbnailRequest) (*Album, *Response, error) {
+	u := albumURL(uid, ab, fmt.Sprintf("/videos/%d/set_album_thumbnail", vid))
+	req, err := s.client.NewRequestWithContext(ctx, "POST", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	album := &Album{}
+	resp, err := s.client.Do(req, album)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return album, resp, nil
+}
@@ -0,0 +1,186 @@
+package vimeo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// AlbumListLogo method gets all the custom logos of the specified album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/showcases#get_showcase_logos
+func (s *UsersService) AlbumListLogo(uid string, ab string, opt ...CallOption) ([]*Pictures, *Response, error) {
+	return s.AlbumListLogoWithContext(context.Background(), uid, ab, opt...)
+}
+
+// AlbumListLogoWithContext method is the same as AlbumListLogo, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumListLogoWithContext(ctx context.Context, uid string, ab string, opt ...CallOption) ([]*Pictures, *Response, error) {
+	u, err := addOptions(albumURL(uid, ab, "/logos"), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pictures := &dataListPictures{}
+
+	resp, err := s.client.Do(req, pictures)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(pictures)
+
+	return pictures.Data, resp, err
+}
+
+// AlbumCreateLogo method adds a custom logo to the specified album. The image is then sent to its Link.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/showcases#create_showcase_logo
+func (s *UsersService) AlbumCreateLogo(uid string, ab string) (*Pictures, *Response, error) {
+	return s.AlbumCreateLogoWithContext(context.Background(), uid, ab)
+}
+
+// AlbumCreateLogoWithContext method is the same as AlbumCreateLogo, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumCreateLogoWithContext(ctx context.Context, uid string, ab string) (*Pictures, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", albumURL(uid, ab, "/logos"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pictures := &Pictures{}
+	resp, err := s.client.Do(req, pictures)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pictures, resp, nil
+}
+
+// AlbumGetLogo method gets a single custom logo of the specified album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/showcases#get_showcase_logo
+func (s *UsersService) AlbumGetLogo(uid string, ab string, lid int, opt ...CallOption) (*Pictures, *Response, error) {
+	return s.AlbumGetLogoWithContext(context.Background(), uid, ab, lid, opt...)
+}
+
+// AlbumGetLogoWithContext method is the same as AlbumGetLogo, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumGetLogoWithContext(ctx context.Context, uid string, ab string, lid int, opt ...CallOption) (*Pictures, *Response, error) {
+	u, err := addOptions(albumURL(uid, ab, fmt.Sprintf("/logos/%d", lid)), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pictures := &Pictures{}
+
+	resp, err := s.client.Do(req, pictures)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pictures, resp, err
+}
+
+// AlbumEditLogo method edits a custom logo of the specified album, for example to make it active.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/showcases#edit_showcase_logo
+func (s *UsersService) AlbumEditLogo(uid string, ab string, lid int, r *PicturesRequest) (*Pictures, *Response, error) {
+	return s.AlbumEditLogoWithContext(context.Background(), uid, ab, lid, r)
+}
+
+// AlbumEditLogoWithContext method is the same as AlbumEditLogo, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumEditLogoWithContext(ctx context.Context, uid string, ab string, lid int, r *PicturesRequest) (*Pictures, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", albumURL(uid, ab, fmt.Sprintf("/logos/%d", lid)), r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pictures := &Pictures{}
+	resp, err := s.client.Do(req, pictures)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pictures, resp, nil
+}
+
+// AlbumDeleteLogo method deletes a custom logo of the specified album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/showcases#delete_showcase_logo
+func (s *UsersService) AlbumDeleteLogo(uid string, ab string, lid int) (*Response, error) {
+	return s.AlbumDeleteLogoWithContext(context.Background(), uid, ab, lid)
+}
+
+// AlbumDeleteLogoWithContext method is the same as AlbumDeleteLogo, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumDeleteLogoWithContext(ctx context.Context, uid string, ab string, lid int) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", albumURL(uid, ab, fmt.Sprintf("/logos/%d", lid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// AlbumUploadLogo shortcut upload custom logo file of the specified album and makes it active.
+// Passing the empty string will edit authenticated user. Only OptProgress and OptBandwidth apply.
+func (s *UsersService) AlbumUploadLogo(uid string, ab string, file *os.File, opt ...UploadOption) (*Pictures, *Response, error) {
+	return s.AlbumUploadLogoWithContext(context.Background(), uid, ab, file, opt...)
+}
+
+// AlbumUploadLogoWithContext method is the same as AlbumUploadLogo, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumUploadLogoWithContext(ctx context.Context, uid string, ab string, file *os.File, opt ...UploadOption) (*Pictures, *Response, error) {
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if stat.IsDir() {
+		return nil, nil, errors.New("the logo file can't be a directory")
+	}
+
+	return s.AlbumUploadLogoFromReaderWithContext(ctx, uid, ab, file, stat.Size(), opt...)
+}
+
+// AlbumUploadLogoFromReader method is the same as AlbumUploadLogo, but reads size bytes of the logo from reader.
+// If reader implements io.ReaderAt, the upload can be retried.
+func (s *UsersService) AlbumUploadLogoFromReader(uid string, ab string, reader io.Reader, size int64, opt ...UploadOption) (*Pictures, *Response, error) {
+	return s.AlbumUploadLogoFromReaderWithContext(context.Background(), uid, ab, reader, size, opt...)
+}
+
+// AlbumUploadLogoFromReaderWithContext method is the same as AlbumUploadLogoFromReader, with the addition of the ability to pass a context.
+func (s *UsersService) AlbumUploadLogoFromReaderWithContext(ctx context.Context, uid string, ab string, reader io.Reader, size int64, opt ...UploadOption) (*Pictures, *Response, error) {
+	if err := checkPictureOptions(opt); err != nil {
+		return nil, nil, err
+	}
+
+	logo, _, err := s.AlbumCreateLogoWithContext(ctx, uid, ab)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := uploadPicture(ctx, s.client, logo.Link, reader, size, opt); err != nil {
+		return nil, nil, err
+	}
+
+	logo, resp, err := s.AlbumEditLogoWithContext(ctx, uid, ab, logo.GetID(), &PicturesRequest{Active: true})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return logo, resp, err
+}
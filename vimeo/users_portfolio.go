@@ -1,6 +1,7 @@
 package vimeo
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -25,6 +26,11 @@ type Portfolio struct {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/portfolios#get_portfolios
 func (s *UsersService) ListPortfolio(uid string, opt ...CallOption) ([]*Portfolio, *Response, error) {
+	return s.ListPortfolioWithContext(context.Background(), uid, opt...)
+}
+
+// ListPortfolioWithContext method is the same as ListPortfolio, with the addition of the ability to pass a context.
+func (s *UsersService) ListPortfolioWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*Portfolio, *Response, error) {
 	var u string
 	if uid == "" {
 		u = "me/portfolios"
@@ -37,7 +43,7 @@ func (s *UsersService) ListPortfolio(uid string, opt ...CallOption) ([]*Portfoli
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -58,6 +64,11 @@ func (s *UsersService) ListPortfolio(uid string, opt ...CallOption) ([]*Portfoli
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/portfolios#get_portfolio
 func (s *UsersService) GetProtfolio(uid string, p string, opt ...CallOption) (*Portfolio, *Response, error) {
+	return s.GetProtfolioWithContext(context.Background(), uid, p, opt...)
+}
+
+// GetProtfolioWithContext method is the same as GetProtfolio, with the addition of the ability to pass a context.
+func (s *UsersService) GetProtfolioWithContext(ctx context.Context, uid string, p string, opt ...CallOption) (*Portfolio, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/portfolios/%s", p)
@@ -70,7 +81,7 @@ func (s *UsersService) GetProtfolio(uid string, p string, opt ...CallOption) (*P
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -89,6 +100,11 @@ func (s *UsersService) GetProtfolio(uid string, p string, opt ...CallOption) (*P
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/portfolios#get_portfolio_videos
 func (s *UsersService) ProtfolioListVideo(uid string, p string, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.ProtfolioListVideoWithContext(context.Background(), uid, p, opt...)
+}
+
+// ProtfolioListVideoWithContext method is the same as ProtfolioListVideo, with the addition of the ability to pass a context.
+func (s *UsersService) ProtfolioListVideoWithContext(ctx context.Context, uid string, p string, opt ...CallOption) ([]*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/portfolios/%s/videos", p)
@@ -96,7 +112,7 @@ func (s *UsersService) ProtfolioListVideo(uid string, p string, opt ...CallOptio
 		u = fmt.Sprintf("users/%s/portfolios/%s/videos", uid, p)
 	}
 
-	videos, resp, err := listVideo(s.client, u, opt...)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
 
 	return videos, resp, err
 }
@@ -105,6 +121,11 @@ func (s *UsersService) ProtfolioListVideo(uid string, p string, opt ...CallOptio
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/portfolios#get_portfolio_video
 func (s *UsersService) ProtfolioGetVideo(uid string, p string, vid int, opt ...CallOption) (*Video, *Response, error) {
+	return s.ProtfolioGetVideoWithContext(context.Background(), uid, p, vid, opt...)
+}
+
+// ProtfolioGetVideoWithContext method is the same as ProtfolioGetVideo, with the addition of the ability to pass a context.
+func (s *UsersService) ProtfolioGetVideoWithContext(ctx context.Context, uid string, p string, vid int, opt ...CallOption) (*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/portfolios/%s/videos/%d", p, vid)
@@ -112,7 +133,7 @@ func (s *UsersService) ProtfolioGetVideo(uid string, p string, vid int, opt ...C
 		u = fmt.Sprintf("users/%s/portfolios/%s/videos/%d", uid, p, vid)
 	}
 
-	video, resp, err := getVideo(s.client, u, opt...)
+	video, resp, err := getVideo(ctx, s.client, u, opt...)
 
 	return video, resp, err
 }
@@ -121,6 +142,11 @@ func (s *UsersService) ProtfolioGetVideo(uid string, p string, vid int, opt ...C
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/portfolios#add_video_to_portfolio
 func (s *UsersService) ProtfolioAddVideo(uid string, p string, vid int) (*Response, error) {
+	return s.ProtfolioAddVideoWithContext(context.Background(), uid, p, vid)
+}
+
+// ProtfolioAddVideoWithContext method is the same as ProtfolioAddVideo, with the addition of the ability to pass a context.
+func (s *UsersService) ProtfolioAddVideoWithContext(ctx context.Context, uid string, p string, vid int) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/portfolios/%s/videos/%d", p, vid)
@@ -128,7 +154,7 @@ func (s *UsersService) ProtfolioAddVideo(uid string, p string, vid int) (*Respon
 		u = fmt.Sprintf("users/%s/portfolios/%s/videos/%d", uid, p, vid)
 	}
 
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +166,11 @@ func (s *UsersService) ProtfolioAddVideo(uid string, p string, vid int) (*Respon
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/portfolios#delete_video_from_portfolio
 func (s *UsersService) ProtfolioDeleteVideo(uid string, p string, vid int) (*Response, error) {
+	return s.ProtfolioDeleteVideoWithContext(context.Background(), uid, p, vid)
+}
+
+// ProtfolioDeleteVideoWithContext method is the same as ProtfolioDeleteVideo, with the addition of the ability to pass a context.
+func (s *UsersService) ProtfolioDeleteVideoWithContext(ctx context.Context, uid string, p string, vid int) (*Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/portfolios/%s/videos/%d", p, vid)
@@ -147,7 +178,7 @@ func (s *UsersService) ProtfolioDeleteVideo(uid string, p string, vid int) (*Res
 		u = fmt.Sprintf("users/%s/portfolios/%s/videos/%d", uid, p, vid)
 	}
 
-	resp, err := deleteVideo(s.client, u)
+	resp, err := deleteVideo(ctx, s.client, u)
 
 	return resp, err
 }
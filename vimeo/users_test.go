@@ -1,8 +1,10 @@
 package vimeo
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
 	"testing"
@@ -551,6 +553,211 @@ func TestUsersService_AlbumDeleteVideo_authenticatedUser(t *testing.T) {
 	}
 }
 
+func TestUsersService_EditAlbum_showcaseSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &AlbumRequest{
+		Layout:          "player",
+		Theme:           "dark",
+		BrandColor:      "ff0000",
+		HideNav:         Bool(false),
+		ReviewMode:      Bool(true),
+		Domain:          "videos.example.com",
+		UseCustomDomain: Bool(true),
+		EmbedCustomLogo: Bool(true),
+	}
+
+	mux.HandleFunc("/me/albums/a", func(w http.ResponseWriter, r *http.Request) {
+		v := map[string]interface{}{}
+		err := json.NewDecoder(r.Body).Decode(&v)
+		if err != nil {
+			t.Fatalf("Users.EditAlbum returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "PATCH")
+		want := map[string]interface{}{
+			"layout":            "player",
+			"theme":             "dark",
+			"brand_color":       "ff0000",
+			"hide_nav":          false,
+			"review_mode":       true,
+			"domain":            "videos.example.com",
+			"use_custom_domain": true,
+			"embed_custom_logo": true,
+		}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Users.EditAlbum body is %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"layout": "player", "review_mode": true, "embed": {"logo": true}}`)
+	})
+
+	album, _, err := client.Users.EditAlbum("", "a", input)
+	if err != nil {
+		t.Errorf("Users.EditAlbum returned unexpected error: %v", err)
+	}
+
+	want := &Album{Layout: "player", ReviewMode: true, Embed: &AlbumEmbed{Logo: true}}
+	if !reflect.DeepEqual(album, want) {
+		t.Errorf("Users.EditAlbum returned %+v, want %+v", album, want)
+	}
+}
+
+func TestUsersService_AlbumSetVideos(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/a/videos", func(w http.ResponseWriter, r *http.Request) {
+		v := map[string]string{}
+		err := json.NewDecoder(r.Body).Decode(&v)
+		if err != nil {
+			t.Fatalf("Users.AlbumSetVideos returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "PUT")
+		want := map[string]string{"videos": "/videos/1,/videos/2"}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Users.AlbumSetVideos body is %+v, want %+v", v, want)
+		}
+	})
+
+	_, err := client.Users.AlbumSetVideos("1", "a", []int{1, 2})
+	if err != nil {
+		t.Errorf("Users.AlbumSetVideos returned unexpected error: %v", err)
+	}
+}
+
+func TestUsersService_AlbumSetFeaturedVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/videos/1/set_featured_video", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	album, _, err := client.Users.AlbumSetFeaturedVideo("", "a", 1)
+	if err != nil {
+		t.Errorf("Users.AlbumSetFeaturedVideo returned unexpected error: %v", err)
+	}
+
+	want := &Album{Name: "Test"}
+	if !reflect.DeepEqual(album, want) {
+		t.Errorf("Users.AlbumSetFeaturedVideo returned %+v, want %+v", album, want)
+	}
+}
+
+func TestUsersService_AlbumSetThumbnail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &AlbumThumbnailRequest{TimeCode: 12.5}
+
+	mux.HandleFunc("/me/albums/a/videos/1/set_album_thumbnail", func(w http.ResponseWriter, r *http.Request) {
+		v := &AlbumThumbnailRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("Users.AlbumSetThumbnail returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Users.AlbumSetThumbnail body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"pictures": {"uri": "/albums/a/pictures/1"}}`)
+	})
+
+	album, _, err := client.Users.AlbumSetThumbnail("", "a", 1, input)
+	if err != nil {
+		t.Errorf("Users.AlbumSetThumbnail returned unexpected error: %v", err)
+	}
+
+	want := &Album{Pictures: &Pictures{URI: "/albums/a/pictures/1"}}
+	if !reflect.DeepEqual(album, want) {
+		t.Errorf("Users.AlbumSetThumbnail returned %+v, want %+v", album, want)
+	}
+}
+
+func TestUsersService_AlbumListLogo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/logos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"uri": "/users/1/albums/a/logos/1", "active": true}]}`)
+	})
+
+	logos, _, err := client.Users.AlbumListLogo("", "a")
+	if err != nil {
+		t.Errorf("Users.AlbumListLogo returned unexpected error: %v", err)
+	}
+
+	want := []*Pictures{{URI: "/users/1/albums/a/logos/1", Active: true}}
+	if !reflect.DeepEqual(logos, want) {
+		t.Errorf("Users.AlbumListLogo returned %+v, want %+v", logos, want)
+	}
+}
+
+func TestUsersService_AlbumUploadLogoFromReader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := []byte("logo")
+
+	mux.HandleFunc("/me/albums/a/logos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprintf(w, `{"uri": "/users/1/albums/a/logos/1", "link": "%s/logo"}`, server.URL)
+	})
+
+	var uploaded []byte
+	mux.HandleFunc("/logo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		uploaded, _ = ioutil.ReadAll(r.Body)
+	})
+
+	mux.HandleFunc("/me/albums/a/logos/1", func(w http.ResponseWriter, r *http.Request) {
+		v := &PicturesRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if want := (&PicturesRequest{Active: true}); !reflect.DeepEqual(v, want) {
+			t.Errorf("Users.AlbumUploadLogoFromReader body is %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"uri": "/users/1/albums/a/logos/1", "active": true}`)
+	})
+
+	logo, _, err := client.Users.AlbumUploadLogoFromReader("", "a", bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("Users.AlbumUploadLogoFromReader returned unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(uploaded, content) {
+		t.Errorf("Uploaded data is %q, want %q", uploaded, content)
+	}
+
+	want := &Pictures{URI: "/users/1/albums/a/logos/1", Active: true}
+	if !reflect.DeepEqual(logo, want) {
+		t.Errorf("Users.AlbumUploadLogoFromReader returned %+v, want %+v", logo, want)
+	}
+}
+
+func TestUsersService_AlbumDeleteLogo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/logos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Users.AlbumDeleteLogo("", "a", 1)
+	if err != nil {
+		t.Errorf("Users.AlbumDeleteLogo returned unexpected error: %v", err)
+	}
+}
+
 func TestUsersService_ListAppearance(t *testing.T) {
 	setup()
 	defer teardown()
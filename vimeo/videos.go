@@ -1,9 +1,12 @@
 package vimeo
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -249,17 +252,29 @@ func (v Video) GetID() int {
 // UploadVideoRequest specifies the optional parameters to the
 // uploadVideo method.
 type UploadVideoRequest struct {
-	Name   string  `json:"name"`
-	Upload *Upload `json:"upload,omitempty"`
+	Name          string             `json:"name,omitempty"`
+	Description   string             `json:"description,omitempty"`
+	License       string             `json:"license,omitempty"`
+	Privacy       *Privacy           `json:"privacy,omitempty"`
+	Password      string             `json:"password,omitempty"`
+	Locale        string             `json:"locale,omitempty"`
+	ContentRating []string           `json:"content_rating,omitempty"`
+	Embed         *EmbedRequest      `json:"embed,omitempty"`
+	ReviewPage    *ReviewPageRequest `json:"review_page,omitempty"`
+	FolderURI     string             `json:"folder_uri,omitempty"`
+	// Tags are added to the video once uploaded. Tags which can't be added are
+	// reported with a *TagsError, returned along with the video.
+	Tags   []string `json:"-"`
+	Upload *Upload  `json:"upload,omitempty"`
 }
 
-func listVideo(c *Client, url string, opt ...CallOption) ([]*Video, *Response, error) {
+func listVideo(ctx context.Context, c *Client, url string, opt ...CallOption) ([]*Video, *Response, error) {
 	u, err := addOptions(url, opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -276,13 +291,13 @@ func listVideo(c *Client, url string, opt ...CallOption) ([]*Video, *Response, e
 	return videos.Data, resp, err
 }
 
-func getVideo(c *Client, url string, opt ...CallOption) (*Video, *Response, error) {
+func getVideo(ctx context.Context, c *Client, url string, opt ...CallOption) (*Video, *Response, error) {
 	u, err := addOptions(url, opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -297,8 +312,8 @@ func getVideo(c *Client, url string, opt ...CallOption) (*Video, *Response, erro
 	return video, resp, err
 }
 
-func getUploadVideo(c *Client, method string, uri string, reqUpload *UploadVideoRequest) (*Video, *Response, error) { // nolint: unparam
-	req, err := c.NewRequest(method, uri, reqUpload)
+func getUploadVideo(ctx context.Context, c *Client, method string, uri string, reqUpload *UploadVideoRequest) (*Video, *Response, error) { // nolint: unparam
+	req, err := c.NewRequestWithContext(ctx, method, uri, reqUpload)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -313,11 +328,7 @@ func getUploadVideo(c *Client, method string, uri string, reqUpload *UploadVideo
 	return video, resp, err
 }
 
-func uploadVideo(c *Client, method string, url string, file *os.File) (*Video, *Response, error) {
-	if c.Config.Uploader == nil {
-		return nil, nil, errors.New("uploader can't be nil if you need upload video")
-	}
-
+func uploadVideo(ctx context.Context, c *Client, method string, url string, file *os.File, opt ...UploadOption) (*Video, *Response, error) {
 	stat, err := file.Stat()
 	if err != nil {
 		return nil, nil, err
@@ -327,55 +338,118 @@ func uploadVideo(c *Client, method string, url string, file *os.File) (*Video, *
 		return nil, nil, errors.New("the video file can't be a directory")
 	}
 
-	reqUpload := &UploadVideoRequest{
-		Name: file.Name(),
-		Upload: &Upload{
-			Approach: "tus",
-			Size:     stat.Size(),
-		},
+	opt = append([]UploadOption{OptSessionKey(fileSessionKey(file, stat))}, opt...)
+
+	return uploadVideoFromReader(ctx, c, method, url, filepath.Base(file.Name()), file, stat.Size(), opt...)
+}
+
+func uploadVideoFromReader(ctx context.Context, c *Client, method string, url string, name string, r io.Reader, size int64, opt ...UploadOption) (*Video, *Response, error) {
+	opts := c.uploadOptions(opt)
+
+	reqUpload := opts.videoRequest(name)
+	reqUpload.Upload = &Upload{
+		Approach: "tus",
+		Size:     size,
 	}
 
-	video, _, err := getUploadVideo(c, method, url, reqUpload)
+	uri, err := uploadFromReader(ctx, c, opts, url, r, size, func(ctx context.Context) (string, string, error) {
+		video, _, err := getUploadVideo(ctx, c, method, url, reqUpload)
+		if err != nil {
+			return "", "", err
+		}
+
+		if video.Upload == nil || video.Upload.UploadLink == "" {
+			return "", "", errors.New("the created video has no upload link")
+		}
+
+		return video.URI, video.Upload.UploadLink, nil
+	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	err = c.Config.Uploader.UploadFromFile(c, video.Upload.UploadLink, file)
+	u := fmt.Sprintf("videos/%d", Video{URI: uri}.GetID())
+	video, resp, err := getVideo(ctx, c, u)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
+	}
+
+	sum, err := opts.checksumOf(r, size)
+	if err != nil {
+		return video, resp, err
+	}
+
+	if err := verifyVideo(video, sum); err != nil {
+		return video, resp, err
+	}
+
+	if len(reqUpload.Tags) > 0 {
+		if resp, err := addUploadTags(ctx, c, video, reqUpload); err != nil {
+			return video, resp, err
+		}
 	}
 
-	u := fmt.Sprintf("videos/%d", video.GetID())
-	completeVideo, resp, err := getVideo(c, u)
+	return video, resp, nil
+}
+
+func uploadVideoByURL(ctx context.Context, c *Client, uri, videoURL string, opt ...UploadOption) (*Video, *Response, error) {
+	reqUpload := c.uploadOptions(opt).videoRequest("")
+	reqUpload.Upload = &Upload{
+		Approach: "pull",
+		Link:     videoURL,
+	}
+
+	video, resp, err := getUploadVideo(ctx, c, "POST", uri, reqUpload)
+	if err != nil || len(reqUpload.Tags) == 0 {
+		return video, resp, err
+	}
 
-	return completeVideo, resp, err
+	if resp, err := addUploadTags(ctx, c, video, reqUpload); err != nil {
+		return video, resp, err
+	}
+
+	return video, resp, nil
 }
 
-func uploadVideoByURL(c *Client, uri, videoURL string) (*Video, *Response, error) {
-	reqUpload := &UploadVideoRequest{
-		Upload: &Upload{
-			Approach: "pull",
-			Link:     videoURL,
-		},
+// TagsError reports the tags which couldn't be added to an uploaded video.
+// The video itself is uploaded and returned along with the error.
+type TagsError struct {
+	Video *Video
+	Tags  []string
+	Err   error
+}
+
+func (e *TagsError) Error() string {
+	return fmt.Sprintf("vimeo: tags not added to %s: %v", e.Video.URI, e.Err)
+}
+
+// Unwrap returns the error of the request adding the tags.
+func (e *TagsError) Unwrap() error {
+	return e.Err
+}
+
+// addUploadTags adds the tags of the upload request to the uploaded video.
+func addUploadTags(ctx context.Context, c *Client, video *Video, reqUpload *UploadVideoRequest) (*Response, error) {
+	tags := make([]*Tag, len(reqUpload.Tags))
+	for i, tag := range reqUpload.Tags {
+		tags[i] = &Tag{Name: tag}
 	}
 
-	req, err := c.NewRequest("POST", uri, reqUpload)
+	req, err := c.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("videos/%d/tags", video.GetID()), tags)
 	if err != nil {
-		return nil, nil, err
+		return nil, &TagsError{Video: video, Tags: reqUpload.Tags, Err: err}
 	}
 
-	video := &Video{}
-
-	resp, err := c.Do(req, video)
+	resp, err := c.Do(req, nil)
 	if err != nil {
-		return nil, resp, err
+		return resp, &TagsError{Video: video, Tags: reqUpload.Tags, Err: err}
 	}
 
-	return video, resp, err
+	return resp, nil
 }
 
-func deleteVideo(c *Client, url string) (*Response, error) {
-	req, err := c.NewRequest("DELETE", url, nil)
+func deleteVideo(ctx context.Context, c *Client, url string) (*Response, error) {
+	req, err := c.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -383,8 +457,8 @@ func deleteVideo(c *Client, url string) (*Response, error) {
 	return c.Do(req, nil)
 }
 
-func addVideo(c *Client, url string) (*Video, *Response, error) {
-	req, err := c.NewRequest("PUT", url, nil)
+func addVideo(ctx context.Context, c *Client, url string) (*Video, *Response, error) {
+	req, err := c.NewRequestWithContext(ctx, "PUT", url, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -403,7 +477,12 @@ func addVideo(c *Client, url string) (*Video, *Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#search_videos
 func (s *VideosService) List(opt ...CallOption) ([]*Video, *Response, error) {
-	videos, resp, err := listVideo(s.client, "videos", opt...)
+	return s.ListWithContext(context.Background(), opt...)
+}
+
+// ListWithContext method is the same as List, with the addition of the ability to pass a context.
+func (s *VideosService) ListWithContext(ctx context.Context, opt ...CallOption) ([]*Video, *Response, error) {
+	videos, resp, err := listVideo(ctx, s.client, "videos", opt...)
 
 	return videos, resp, err
 }
@@ -412,8 +491,13 @@ func (s *VideosService) List(opt ...CallOption) ([]*Video, *Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video
 func (s *VideosService) Get(vid int, opt ...CallOption) (*Video, *Response, error) {
+	return s.GetWithContext(context.Background(), vid, opt...)
+}
+
+// GetWithContext method is the same as Get, with the addition of the ability to pass a context.
+func (s *VideosService) GetWithContext(ctx context.Context, vid int, opt ...CallOption) (*Video, *Response, error) {
 	u := fmt.Sprintf("videos/%d", vid)
-	video, resp, err := getVideo(s.client, u, opt...)
+	video, resp, err := getVideo(ctx, s.client, u, opt...)
 
 	return video, resp, err
 }
@@ -422,8 +506,13 @@ func (s *VideosService) Get(vid int, opt ...CallOption) (*Video, *Response, erro
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#edit_video
 func (s *VideosService) Edit(vid int, r *VideoRequest) (*Video, *Response, error) {
+	return s.EditWithContext(context.Background(), vid, r)
+}
+
+// EditWithContext method is the same as Edit, with the addition of the ability to pass a context.
+func (s *VideosService) EditWithContext(ctx context.Context, vid int, r *VideoRequest) (*Video, *Response, error) {
 	u := fmt.Sprintf("videos/%d", vid)
-	req, err := s.client.NewRequest("PATCH", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -441,8 +530,13 @@ func (s *VideosService) Edit(vid int, r *VideoRequest) (*Video, *Response, error
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_video
 func (s *VideosService) Delete(vid int) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), vid)
+}
+
+// DeleteWithContext method is the same as Delete, with the addition of the ability to pass a context.
+func (s *VideosService) DeleteWithContext(ctx context.Context, vid int) (*Response, error) {
 	u := fmt.Sprintf("videos/%d", vid)
-	resp, err := deleteVideo(s.client, u)
+	resp, err := deleteVideo(ctx, s.client, u)
 
 	return resp, err
 }
@@ -451,18 +545,43 @@ func (s *VideosService) Delete(vid int) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/categories#get_video_categories
 func (s *VideosService) ListCategory(vid int, opt ...CallOption) ([]*Category, *Response, error) {
+	return s.ListCategoryWithContext(context.Background(), vid, opt...)
+}
+
+// ListCategoryWithContext method is the same as ListCategory, with the addition of the ability to pass a context.
+func (s *VideosService) ListCategoryWithContext(ctx context.Context, vid int, opt ...CallOption) ([]*Category, *Response, error) {
 	u := fmt.Sprintf("videos/%d/categories", vid)
-	catogories, resp, err := listCategory(s.client, u, opt...)
+	catogories, resp, err := listCategory(ctx, s.client, u, opt...)
 
 	return catogories, resp, err
 }
 
+// ListAlbum method gets all the albums (showcases) that contain a particular video.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/showcases
+func (s *VideosService) ListAlbum(vid int, opt ...CallOption) ([]*Album, *Response, error) {
+	return s.ListAlbumWithContext(context.Background(), vid, opt...)
+}
+
+// ListAlbumWithContext method is the same as ListAlbum, with the addition of the ability to pass a context.
+func (s *VideosService) ListAlbumWithContext(ctx context.Context, vid int, opt ...CallOption) ([]*Album, *Response, error) {
+	u := fmt.Sprintf("videos/%d/albums", vid)
+	albums, resp, err := listAlbum(ctx, s.client, u, opt...)
+
+	return albums, resp, err
+}
+
 // LikeList method gets all the users who have liked a particular video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/likes#get_video_likes
 func (s *VideosService) LikeList(vid int, opt ...CallOption) ([]*User, *Response, error) {
+	return s.LikeListWithContext(context.Background(), vid, opt...)
+}
+
+// LikeListWithContext method is the same as LikeList, with the addition of the ability to pass a context.
+func (s *VideosService) LikeListWithContext(ctx context.Context, vid int, opt ...CallOption) ([]*User, *Response, error) {
 	u := fmt.Sprintf("videos/%d/likes", vid)
-	users, resp, err := listUser(s.client, u, opt...)
+	users, resp, err := listUser(ctx, s.client, u, opt...)
 
 	return users, resp, err
 }
@@ -471,8 +590,13 @@ func (s *VideosService) LikeList(vid int, opt ...CallOption) ([]*User, *Response
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#get_video_embed_preset
 func (s *VideosService) GetPreset(vid int, p int) (*Preset, *Response, error) {
+	return s.GetPresetWithContext(context.Background(), vid, p)
+}
+
+// GetPresetWithContext method is the same as GetPreset, with the addition of the ability to pass a context.
+func (s *VideosService) GetPresetWithContext(ctx context.Context, vid int, p int) (*Preset, *Response, error) {
 	u := fmt.Sprintf("videos/%d/presets/%d", vid, p)
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -491,8 +615,13 @@ func (s *VideosService) GetPreset(vid int, p int) (*Preset, *Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#add_video_embed_preset
 func (s *VideosService) AssignPreset(vid int, p int) (*Response, error) {
+	return s.AssignPresetWithContext(context.Background(), vid, p)
+}
+
+// AssignPresetWithContext method is the same as AssignPreset, with the addition of the ability to pass a context.
+func (s *VideosService) AssignPresetWithContext(ctx context.Context, vid int, p int) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/presets/%d", vid, p)
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -504,8 +633,13 @@ func (s *VideosService) AssignPreset(vid int, p int) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#delete_video_embed_preset
 func (s *VideosService) UnassignPreset(vid int, p int) (*Response, error) {
+	return s.UnassignPresetWithContext(context.Background(), vid, p)
+}
+
+// UnassignPresetWithContext method is the same as UnassignPreset, with the addition of the ability to pass a context.
+func (s *VideosService) UnassignPresetWithContext(ctx context.Context, vid int, p int) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/presets/%d", vid, p)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -528,12 +662,17 @@ type Domain struct {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_privacy_domains
 func (s *VideosService) ListDomain(vid int, opt ...CallOption) ([]*Domain, *Response, error) {
+	return s.ListDomainWithContext(context.Background(), vid, opt...)
+}
+
+// ListDomainWithContext method is the same as ListDomain, with the addition of the ability to pass a context.
+func (s *VideosService) ListDomainWithContext(ctx context.Context, vid int, opt ...CallOption) ([]*Domain, *Response, error) {
 	u, err := addOptions(fmt.Sprintf("videos/%d/privacy/domains", vid), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -554,8 +693,13 @@ func (s *VideosService) ListDomain(vid int, opt ...CallOption) ([]*Domain, *Resp
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#add_video_privacy_domain
 func (s *VideosService) AllowDomain(vid int, d string) (*Response, error) {
+	return s.AllowDomainWithContext(context.Background(), vid, d)
+}
+
+// AllowDomainWithContext method is the same as AllowDomain, with the addition of the ability to pass a context.
+func (s *VideosService) AllowDomainWithContext(ctx context.Context, vid int, d string) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/privacy/domains/%s", vid, d)
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -567,8 +711,13 @@ func (s *VideosService) AllowDomain(vid int, d string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_video_privacy_domain
 func (s *VideosService) DisallowDomain(vid int, d string) (*Response, error) {
+	return s.DisallowDomainWithContext(context.Background(), vid, d)
+}
+
+// DisallowDomainWithContext method is the same as DisallowDomain, with the addition of the ability to pass a context.
+func (s *VideosService) DisallowDomainWithContext(ctx context.Context, vid int, d string) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/privacy/domains/%s", vid, d)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -580,8 +729,13 @@ func (s *VideosService) DisallowDomain(vid int, d string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_privacy_users
 func (s *VideosService) ListUser(vid int, opt ...CallOption) ([]*User, *Response, error) {
+	return s.ListUserWithContext(context.Background(), vid, opt...)
+}
+
+// ListUserWithContext method is the same as ListUser, with the addition of the ability to pass a context.
+func (s *VideosService) ListUserWithContext(ctx context.Context, vid int, opt ...CallOption) ([]*User, *Response, error) {
 	u := fmt.Sprintf("videos/%d/privacy/users", vid)
-	users, resp, err := listUser(s.client, u, opt...)
+	users, resp, err := listUser(ctx, s.client, u, opt...)
 
 	return users, resp, err
 }
@@ -590,8 +744,13 @@ func (s *VideosService) ListUser(vid int, opt ...CallOption) ([]*User, *Response
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#add_video_privacy_users
 func (s *VideosService) AllowUsers(vid int) (*Response, error) {
+	return s.AllowUsersWithContext(context.Background(), vid)
+}
+
+// AllowUsersWithContext method is the same as AllowUsers, with the addition of the ability to pass a context.
+func (s *VideosService) AllowUsersWithContext(ctx context.Context, vid int) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/privacy/users", vid)
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -603,8 +762,13 @@ func (s *VideosService) AllowUsers(vid int) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#add_video_privacy_user
 func (s *VideosService) AllowUser(vid int, uid string) (*Response, error) {
+	return s.AllowUserWithContext(context.Background(), vid, uid)
+}
+
+// AllowUserWithContext method is the same as AllowUser, with the addition of the ability to pass a context.
+func (s *VideosService) AllowUserWithContext(ctx context.Context, vid int, uid string) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/privacy/users/%s", vid, uid)
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -616,8 +780,13 @@ func (s *VideosService) AllowUser(vid int, uid string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_video_privacy_user
 func (s *VideosService) DisallowUser(vid int, uid string) (*Response, error) {
+	return s.DisallowUserWithContext(context.Background(), vid, uid)
+}
+
+// DisallowUserWithContext method is the same as DisallowUser, with the addition of the ability to pass a context.
+func (s *VideosService) DisallowUserWithContext(ctx context.Context, vid int, uid string) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/privacy/users/%s", vid, uid)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -629,8 +798,13 @@ func (s *VideosService) DisallowUser(vid int, uid string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_tags
 func (s *VideosService) ListTag(vid int, opt ...CallOption) ([]*Tag, *Response, error) {
+	return s.ListTagWithContext(context.Background(), vid, opt...)
+}
+
+// ListTagWithContext method is the same as ListTag, with the addition of the ability to pass a context.
+func (s *VideosService) ListTagWithContext(ctx context.Context, vid int, opt ...CallOption) ([]*Tag, *Response, error) {
 	u := fmt.Sprintf("videos/%d/tags", vid)
-	tags, resp, err := listTag(s.client, u, opt...)
+	tags, resp, err := listTag(ctx, s.client, u, opt...)
 
 	return tags, resp, err
 }
@@ -639,8 +813,13 @@ func (s *VideosService) ListTag(vid int, opt ...CallOption) ([]*Tag, *Response,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#check_video_for_tag
 func (s *VideosService) GetTag(vid int, t string, opt ...CallOption) (*Tag, *Response, error) {
+	return s.GetTagWithContext(context.Background(), vid, t, opt...)
+}
+
+// GetTagWithContext method is the same as GetTag, with the addition of the ability to pass a context.
+func (s *VideosService) GetTagWithContext(ctx context.Context, vid int, t string, opt ...CallOption) (*Tag, *Response, error) {
 	u := fmt.Sprintf("videos/%d/tags/%s", vid, t)
-	tag, resp, err := getTag(s.client, u, opt...)
+	tag, resp, err := getTag(ctx, s.client, u, opt...)
 
 	return tag, resp, err
 }
@@ -649,8 +828,13 @@ func (s *VideosService) GetTag(vid int, t string, opt ...CallOption) (*Tag, *Res
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#add_video_tag
 func (s *VideosService) AssignTag(vid int, t string) (*Response, error) {
+	return s.AssignTagWithContext(context.Background(), vid, t)
+}
+
+// AssignTagWithContext method is the same as AssignTag, with the addition of the ability to pass a context.
+func (s *VideosService) AssignTagWithContext(ctx context.Context, vid int, t string) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/tags/%s", vid, t)
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -662,8 +846,13 @@ func (s *VideosService) AssignTag(vid int, t string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_video_tag
 func (s *VideosService) UnassignTag(vid int, t string) (*Response, error) {
+	return s.UnassignTagWithContext(context.Background(), vid, t)
+}
+
+// UnassignTagWithContext method is the same as UnassignTag, with the addition of the ability to pass a context.
+func (s *VideosService) UnassignTagWithContext(ctx context.Context, vid int, t string) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/tags/%s", vid, t)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -675,18 +864,13 @@ func (s *VideosService) UnassignTag(vid int, t string) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_related_videos
 func (s *VideosService) ListRelatedVideo(vid int, opt ...CallOption) ([]*Video, *Response, error) {
-	u := fmt.Sprintf("videos/%d/videos", vid)
-	videos, resp, err := listVideo(s.client, u, opt...)
-
-	return videos, resp, err
+	return s.ListRelatedVideoWithContext(context.Background(), vid, opt...)
 }
 
-// ReplaceFile method adds a version to the specified video.
-//
-// Vimeo API docs: https://developer.vimeo.com/api/reference/videos#create_video_version
-func (s *VideosService) ReplaceFile(vid int, file *os.File) (*Video, *Response, error) {
-	u := fmt.Sprintf("videos/%d/versions", vid)
-	video, resp, err := uploadVideo(s.client, "POST", u, file)
+// ListRelatedVideoWithContext method is the same as ListRelatedVideo, with the addition of the ability to pass a context.
+func (s *VideosService) ListRelatedVideoWithContext(ctx context.Context, vid int, opt ...CallOption) ([]*Video, *Response, error) {
+	u := fmt.Sprintf("videos/%d/videos", vid)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
 
-	return video, resp, err
+	return videos, resp, err
 }
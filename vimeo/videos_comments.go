@@ -1,6 +1,9 @@
 package vimeo
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type dataListComment struct {
 	Data []*Comment `json:"data,omitempty"`
@@ -26,12 +29,17 @@ type CommentRequest struct {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_comments
 func (s *VideosService) ListComment(vid int, opt ...CallOption) ([]*Comment, *Response, error) {
+	return s.ListCommentWithContext(context.Background(), vid, opt...)
+}
+
+// ListCommentWithContext method is the same as ListComment, with the addition of the ability to pass a context.
+func (s *VideosService) ListCommentWithContext(ctx context.Context, vid int, opt ...CallOption) ([]*Comment, *Response, error) {
 	u, err := addOptions(fmt.Sprintf("videos/%d/comments", vid), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -52,8 +60,13 @@ func (s *VideosService) ListComment(vid int, opt ...CallOption) ([]*Comment, *Re
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#create_comment
 func (s *VideosService) AddComment(vid int, r *CommentRequest) (*Comment, *Response, error) {
+	return s.AddCommentWithContext(context.Background(), vid, r)
+}
+
+// AddCommentWithContext method is the same as AddComment, with the addition of the ability to pass a context.
+func (s *VideosService) AddCommentWithContext(ctx context.Context, vid int, r *CommentRequest) (*Comment, *Response, error) {
 	u := fmt.Sprintf("videos/%d/comments", vid)
-	req, err := s.client.NewRequest("POST", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -71,12 +84,17 @@ func (s *VideosService) AddComment(vid int, r *CommentRequest) (*Comment, *Respo
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_comment
 func (s *VideosService) GetComment(vid int, cid int, opt ...CallOption) (*Comment, *Response, error) {
+	return s.GetCommentWithContext(context.Background(), vid, cid, opt...)
+}
+
+// GetCommentWithContext method is the same as GetComment, with the addition of the ability to pass a context.
+func (s *VideosService) GetCommentWithContext(ctx context.Context, vid int, cid int, opt ...CallOption) (*Comment, *Response, error) {
 	u, err := addOptions(fmt.Sprintf("videos/%d/comments/%d", vid, cid), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -95,8 +113,13 @@ func (s *VideosService) GetComment(vid int, cid int, opt ...CallOption) (*Commen
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#edit_comment
 func (s *VideosService) EditComment(vid int, cid int, r *CommentRequest) (*Comment, *Response, error) {
+	return s.EditCommentWithContext(context.Background(), vid, cid, r)
+}
+
+// EditCommentWithContext method is the same as EditComment, with the addition of the ability to pass a context.
+func (s *VideosService) EditCommentWithContext(ctx context.Context, vid int, cid int, r *CommentRequest) (*Comment, *Response, error) {
 	u := fmt.Sprintf("videos/%d/comments/%d", vid, cid)
-	req, err := s.client.NewRequest("PATCH", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -114,8 +137,13 @@ func (s *VideosService) EditComment(vid int, cid int, r *CommentRequest) (*Comme
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_comment
 func (s *VideosService) DeleteComment(vid int, cid int) (*Response, error) {
+	return s.DeleteCommentWithContext(context.Background(), vid, cid)
+}
+
+// DeleteCommentWithContext method is the same as DeleteComment, with the addition of the ability to pass a context.
+func (s *VideosService) DeleteCommentWithContext(ctx context.Context, vid int, cid int) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/comments/%d", vid, cid)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -127,12 +155,17 @@ func (s *VideosService) DeleteComment(vid int, cid int) (*Response, error) {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_comment_replies
 func (s *VideosService) ListReplies(vid int, cid int, opt ...CallOption) ([]*Comment, *Response, error) {
+	return s.ListRepliesWithContext(context.Background(), vid, cid, opt...)
+}
+
+// ListRepliesWithContext method is the same as ListReplies, with the addition of the ability to pass a context.
+func (s *VideosService) ListRepliesWithContext(ctx context.Context, vid int, cid int, opt ...CallOption) ([]*Comment, *Response, error) {
 	u, err := addOptions(fmt.Sprintf("videos/%d/comments/%d/replies", vid, cid), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -153,8 +186,13 @@ func (s *VideosService) ListReplies(vid int, cid int, opt ...CallOption) ([]*Com
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#create_comment_reply
 func (s *VideosService) AddReplies(vid int, cid int, r *CommentRequest) (*Comment, *Response, error) {
+	return s.AddRepliesWithContext(context.Background(), vid, cid, r)
+}
+
+// AddRepliesWithContext method is the same as AddReplies, with the addition of the ability to pass a context.
+func (s *VideosService) AddRepliesWithContext(ctx context.Context, vid int, cid int, r *CommentRequest) (*Comment, *Response, error) {
 	u := fmt.Sprintf("videos/%d/comments/%d/replies", vid, cid)
-	req, err := s.client.NewRequest("POST", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
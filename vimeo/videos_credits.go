@@ -1,6 +1,9 @@
 package vimeo
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type dataListCredit struct {
 	Data []*Credit `json:"data,omitempty"`
@@ -28,12 +31,17 @@ type CreditRequest struct {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_credits
 func (s *VideosService) ListCredit(vid int, opt ...CallOption) ([]*Credit, *Response, error) {
+	return s.ListCreditWithContext(context.Background(), vid, opt...)
+}
+
+// ListCreditWithContext method is the same as ListCredit, with the addition of the ability to pass a context.
+func (s *VideosService) ListCreditWithContext(ctx context.Context, vid int, opt ...CallOption) ([]*Credit, *Response, error) {
 	u, err := addOptions(fmt.Sprintf("videos/%d/credits", vid), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -54,8 +62,13 @@ func (s *VideosService) ListCredit(vid int, opt ...CallOption) ([]*Credit, *Resp
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#add_video_credit
 func (s *VideosService) AddCredit(vid int, r *CreditRequest) (*Credit, *Response, error) {
+	return s.AddCreditWithContext(context.Background(), vid, r)
+}
+
+// AddCreditWithContext method is the same as AddCredit, with the addition of the ability to pass a context.
+func (s *VideosService) AddCreditWithContext(ctx context.Context, vid int, r *CreditRequest) (*Credit, *Response, error) {
 	u := fmt.Sprintf("videos/%d/credits", vid)
-	req, err := s.client.NewRequest("POST", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -73,12 +86,17 @@ func (s *VideosService) AddCredit(vid int, r *CreditRequest) (*Credit, *Response
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_credit
 func (s *VideosService) GetCredit(vid int, cid int, opt ...CallOption) (*Credit, *Response, error) {
+	return s.GetCreditWithContext(context.Background(), vid, cid, opt...)
+}
+
+// GetCreditWithContext method is the same as GetCredit, with the addition of the ability to pass a context.
+func (s *VideosService) GetCreditWithContext(ctx context.Context, vid int, cid int, opt ...CallOption) (*Credit, *Response, error) {
 	u, err := addOptions(fmt.Sprintf("videos/%d/credits/%d", vid, cid), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -97,8 +115,13 @@ func (s *VideosService) GetCredit(vid int, cid int, opt ...CallOption) (*Credit,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#edit_video_credit
 func (s *VideosService) EditCredit(vid int, cid int, r *CreditRequest) (*Credit, *Response, error) {
+	return s.EditCreditWithContext(context.Background(), vid, cid, r)
+}
+
+// EditCreditWithContext method is the same as EditCredit, with the addition of the ability to pass a context.
+func (s *VideosService) EditCreditWithContext(ctx context.Context, vid int, cid int, r *CreditRequest) (*Credit, *Response, error) {
 	u := fmt.Sprintf("videos/%d/credits/%d", vid, cid)
-	req, err := s.client.NewRequest("PATCH", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -116,8 +139,13 @@ func (s *VideosService) EditCredit(vid int, cid int, r *CreditRequest) (*Credit,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_video_credit
 func (s *VideosService) DeleteCredit(vid int, cid int) (*Response, error) {
+	return s.DeleteCreditWithContext(context.Background(), vid, cid)
+}
+
+// DeleteCreditWithContext method is the same as DeleteCredit, with the addition of the ability to pass a context.
+func (s *VideosService) DeleteCreditWithContext(ctx context.Context, vid int, cid int) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/credits/%d", vid, cid)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,318 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+)
+
+// maxDownloadResumes is the number of times a download is resumed in a row without receiving any byte.
+const maxDownloadResumes = 3
+
+// DownloadOption is an optional argument to a download, selecting the rendition of the video
+// or limiting its bandwidth. By default, the rendition with the highest resolution is downloaded.
+type DownloadOption interface {
+	applyDownload(*downloadOptions)
+}
+
+type downloadOptions struct {
+	quality   string
+	typ       string
+	maxHeight int
+	bandwidth *Bandwidth
+}
+
+// OptQuality selects the rendition of a download by quality, such as source, hd or sd.
+type OptQuality string
+
+func (o OptQuality) applyDownload(d *downloadOptions) {
+	d.quality = string(o)
+}
+
+// OptType selects the rendition of a download by type, such as video/mp4 or source.
+type OptType string
+
+func (o OptType) applyDownload(d *downloadOptions) {
+	d.typ = string(o)
+}
+
+// OptMaxHeight selects the rendition of a download among those not higher than the given number of pixels.
+type OptMaxHeight int
+
+func (o OptMaxHeight) applyDownload(d *downloadOptions) {
+	d.maxHeight = int(o)
+}
+
+// rendition returns the rendition of the video with the highest resolution matching the options, if any.
+func (o *downloadOptions) rendition(video *Video) *Download {
+	var best *Download
+	for _, d := range renditions(video) {
+		if o.quality != "" && d.Quality != o.quality {
+			continue
+		}
+		if o.typ != "" && d.Type != o.typ {
+			continue
+		}
+		if o.maxHeight > 0 && d.Height > o.maxHeight {
+			continue
+		}
+
+		if best == nil || d.Height > best.Height || d.Height == best.Height && d.Size > best.Size {
+			best = d
+		}
+	}
+
+	return best
+}
+
+// renditions returns the downloads of the video, or its files when it has no download.
+func renditions(video *Video) []*Download {
+	if len(video.Download) > 0 {
+		return video.Download
+	}
+
+	downloads := make([]*Download, 0, len(video.Files))
+	for _, f := range video.Files {
+		downloads = append(downloads, &Download{
+			Quality:     f.Quality,
+			Type:        f.Type,
+			Width:       f.Width,
+			Height:      f.Height,
+			Link:        f.Link,
+			CreatedTime: f.CreatedTime,
+			Fps:         float64(f.FPS),
+			Size:        f.Size,
+			Md5:         f.MD5,
+		})
+	}
+
+	return downloads
+}
+
+// download writes a rendition of a video to w, computing the checksum of the bytes written.
+type download struct {
+	c    *Client
+	vid  int
+	opts downloadOptions
+	w    io.Writer
+	sum  *checksum
+
+	video     *Video
+	rendition *Download
+}
+
+func newDownload(c *Client, vid int, w io.Writer, opt []DownloadOption) *download {
+	d := &download{c: c, vid: vid, w: w, sum: newChecksum()}
+	d.opts.bandwidth = c.Config.Bandwidth
+	for _, o := range opt {
+		o.applyDownload(&d.opts)
+	}
+
+	return d
+}
+
+func (d *download) Write(p []byte) (int, error) {
+	n, err := d.w.Write(p)
+	d.sum.Write(p[:n]) // nolint: errcheck
+	return n, err
+}
+
+// refresh fetches the video to select the rendition and get a new link.
+// The rendition must not change while it is downloaded.
+func (d *download) refresh(ctx context.Context) (*Response, error) {
+	video, resp, err := getVideo(ctx, d.c, fmt.Sprintf("videos/%d", d.vid), OptFields{"uri", "download", "files"})
+	if err != nil {
+		return resp, err
+	}
+
+	rendition := d.opts.rendition(video)
+	if rendition == nil {
+		return resp, ErrNoDownload
+	}
+
+	if d.rendition != nil && (rendition.Size != d.rendition.Size || rendition.Md5 != d.rendition.Md5) {
+		return resp, fmt.Errorf("vimeo: the %v rendition of %v changed during the download", rendition.Quality, video.URI)
+	}
+
+	d.video, d.rendition = video, rendition
+	return resp, nil
+}
+
+// expired reports whether the link of the rendition has expired.
+func (d *download) expired() bool {
+	return !d.rendition.Expires.IsZero() && time.Now().After(d.rendition.Expires)
+}
+
+// run downloads the rest of the rendition, resuming the transfer after a failure.
+func (d *download) run(ctx context.Context) (*Response, error) {
+	resp, err := d.refresh(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	d.c.log(ctx, "vimeo: download started",
+		slog.String("video", d.video.URI),
+		slog.Int("size", d.rendition.Size),
+		slog.Int64("offset", d.sum.n),
+	)
+
+	resumes := 0
+	for d.rendition.Size == 0 || d.sum.n < int64(d.rendition.Size) {
+		offset := d.sum.n
+
+		expired, err := d.get(ctx, offset)
+		if err == nil {
+			if d.rendition.Size == 0 || d.sum.n >= int64(d.rendition.Size) {
+				break
+			}
+			err = io.ErrUnexpectedEOF
+		}
+
+		if d.sum.n > offset {
+			resumes = 0
+		}
+		if ctx.Err() != nil || resumes >= maxDownloadResumes {
+			return resp, err
+		}
+		resumes++
+
+		d.c.log(ctx, "vimeo: download resumed",
+			slog.String("video", d.video.URI),
+			slog.Int64("offset", d.sum.n),
+			slog.String("error", d.c.redactError(err, d.rendition.Link)),
+		)
+
+		if expired || d.expired() {
+			resp, err = d.refresh(ctx)
+			if err != nil {
+				return resp, err
+			}
+		}
+	}
+
+	sum := d.sum.result()
+	if d.rendition.Size != 0 && int64(d.rendition.Size) != sum.Size {
+		return resp, &VerificationError{URI: d.video.URI, Video: d.video, Field: "size", Sent: fmt.Sprint(d.rendition.Size), Received: fmt.Sprint(sum.Size)}
+	}
+
+	if d.rendition.Md5 != "" && d.rendition.Md5 != sum.MD5 {
+		return resp, &VerificationError{URI: d.video.URI, Video: d.video, Field: "md5", Sent: d.rendition.Md5, Received: sum.MD5}
+	}
+
+	d.c.log(ctx, "vimeo: download finished",
+		slog.String("video", d.video.URI),
+		slog.Int64("size", sum.Size),
+	)
+
+	return resp, nil
+}
+
+// get writes the bytes of the rendition from offset, limited to the bandwidth of the download.
+// It reports whether the link of the rendition was refused, as it does once expired.
+// The request goes through the Config.Middleware chain, like the API calls.
+func (d *download) get(ctx context.Context, offset int64) (expired bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", d.rendition.Link, nil)
+	if err != nil {
+		return false, err
+	}
+
+	if d.c.UserAgent != "" {
+		req.Header.Set("User-Agent", d.c.UserAgent)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	_, err = d.c.chain(func(req *http.Request, _ interface{}) (response *Response, err error) {
+		start := time.Now()
+		resp, attempts, err := d.c.send(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		response = newResponse(resp)
+		response.Attempts = attempts
+		if d.c.logger() != nil {
+			defer func() {
+				d.c.logCall(req, response, err, time.Since(start), nil)
+			}()
+		}
+
+		// The whole rendition has been written already.
+		if offset > 0 && resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			return response, nil
+		}
+
+		if err := d.c.checkResponse(resp); err != nil {
+			switch resp.StatusCode {
+			case http.StatusForbidden, http.StatusNotFound, http.StatusGone:
+				expired = true
+			}
+			return response, err
+		}
+
+		// The server ignored the range and sends the whole rendition.
+		if offset > 0 && resp.StatusCode != http.StatusPartialContent {
+			if _, err := io.CopyN(io.Discard, resp.Body, offset); err != nil {
+				return response, err
+			}
+		}
+
+		_, err = io.Copy(d, d.opts.bandwidth.reader(ctx, resp.Body))
+		return response, err
+	})(req, nil)
+
+	return expired, err
+}
+
+// Download method writes a rendition of the video to w, the one with the highest resolution
+// unless selected with the options. A failed transfer is resumed from the last byte received,
+// with a new link if it expired. The size and MD5 of the bytes are then compared to those reported
+// by Vimeo, returning a *VerificationError if they differ. Config.Bandwidth, or OptBandwidth, limits the transfer.
+// It returns the downloaded rendition, or ErrNoDownload if the video has none matching the options.
+func (s *VideosService) Download(vid int, w io.Writer, opt ...DownloadOption) (*Download, *Response, error) {
+	return s.DownloadWithContext(context.Background(), vid, w, opt...)
+}
+
+// DownloadWithContext method is the same as Download, with the addition of the ability to pass a context.
+func (s *VideosService) DownloadWithContext(ctx context.Context, vid int, w io.Writer, opt ...DownloadOption) (*Download, *Response, error) {
+	d := newDownload(s.client, vid, w, opt)
+
+	resp, err := d.run(ctx)
+
+	return d.rendition, resp, err
+}
+
+// DownloadFile method is the same as Download, but writes the rendition to the named file.
+// An existing file is resumed: it must hold the start of the same rendition.
+func (s *VideosService) DownloadFile(vid int, name string, opt ...DownloadOption) (*Download, *Response, error) {
+	return s.DownloadFileWithContext(context.Background(), vid, name, opt...)
+}
+
+// DownloadFileWithContext method is the same as DownloadFile, with the addition of the ability to pass a context.
+func (s *VideosService) DownloadFileWithContext(ctx context.Context, vid int, name string, opt ...DownloadOption) (*Download, *Response, error) {
+	file, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := newDownload(s.client, vid, file, opt)
+
+	// Reading the existing bytes computes their checksum and moves to the end of the file.
+	if _, err := io.Copy(d.sum, file); err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+
+	resp, err := d.run(ctx)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+
+	return d.rendition, resp, err
+}
@@ -1,8 +1,10 @@
 package vimeo
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"strconv"
@@ -58,12 +60,17 @@ func (v Pictures) GetID() int {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_thumbnails
 func (s *VideosService) ListPictures(vid int, opt ...CallOption) ([]*Pictures, *Response, error) {
+	return s.ListPicturesWithContext(context.Background(), vid, opt...)
+}
+
+// ListPicturesWithContext method is the same as ListPictures, with the addition of the ability to pass a context.
+func (s *VideosService) ListPicturesWithContext(ctx context.Context, vid int, opt ...CallOption) ([]*Pictures, *Response, error) {
 	u, err := addOptions(fmt.Sprintf("videos/%d/pictures", vid), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -84,8 +91,13 @@ func (s *VideosService) ListPictures(vid int, opt ...CallOption) ([]*Pictures, *
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#create_video_thumbnail
 func (s *VideosService) CreatePictures(vid int, r *PicturesRequest) (*Pictures, *Response, error) {
+	return s.CreatePicturesWithContext(context.Background(), vid, r)
+}
+
+// CreatePicturesWithContext method is the same as CreatePictures, with the addition of the ability to pass a context.
+func (s *VideosService) CreatePicturesWithContext(ctx context.Context, vid int, r *PicturesRequest) (*Pictures, *Response, error) {
 	u := fmt.Sprintf("videos/%d/pictures", vid)
-	req, err := s.client.NewRequest("POST", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -103,12 +115,17 @@ func (s *VideosService) CreatePictures(vid int, r *PicturesRequest) (*Pictures,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_thumbnail
 func (s *VideosService) GetPictures(vid int, pid int, opt ...CallOption) (*Pictures, *Response, error) {
+	return s.GetPicturesWithContext(context.Background(), vid, pid, opt...)
+}
+
+// GetPicturesWithContext method is the same as GetPictures, with the addition of the ability to pass a context.
+func (s *VideosService) GetPicturesWithContext(ctx context.Context, vid int, pid int, opt ...CallOption) (*Pictures, *Response, error) {
 	u, err := addOptions(fmt.Sprintf("videos/%d/pictures/%d", vid, pid), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -127,8 +144,13 @@ func (s *VideosService) GetPictures(vid int, pid int, opt ...CallOption) (*Pictu
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#edit_video_thumbnail
 func (s *VideosService) EditPictures(vid int, pid int, r *PicturesRequest) (*Pictures, *Response, error) {
+	return s.EditPicturesWithContext(context.Background(), vid, pid, r)
+}
+
+// EditPicturesWithContext method is the same as EditPictures, with the addition of the ability to pass a context.
+func (s *VideosService) EditPicturesWithContext(ctx context.Context, vid int, pid int, r *PicturesRequest) (*Pictures, *Response, error) {
 	u := fmt.Sprintf("videos/%d/pictures/%d", vid, pid)
-	req, err := s.client.NewRequest("PATCH", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -146,8 +168,13 @@ func (s *VideosService) EditPictures(vid int, pid int, r *PicturesRequest) (*Pic
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_video_thumbnail
 func (s *VideosService) DeletePictures(vid int, pid int) (*Response, error) {
+	return s.DeletePicturesWithContext(context.Background(), vid, pid)
+}
+
+// DeletePicturesWithContext method is the same as DeletePictures, with the addition of the ability to pass a context.
+func (s *VideosService) DeletePicturesWithContext(ctx context.Context, vid int, pid int) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/pictures/%d", vid, pid)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -156,12 +183,13 @@ func (s *VideosService) DeletePictures(vid int, pid int) (*Response, error) {
 }
 
 // UploadPicture shortcut upload picture file.
-func (s *VideosService) UploadPicture(vid int, r *PicturesRequest, file *os.File) (*Pictures, *Response, error) {
-	pictures, _, err := s.CreatePictures(vid, r)
-	if err != nil {
-		return nil, nil, err
-	}
+// Only OptProgress and OptBandwidth apply to picture uploads.
+func (s *VideosService) UploadPicture(vid int, r *PicturesRequest, file *os.File, opt ...UploadOption) (*Pictures, *Response, error) {
+	return s.UploadPictureWithContext(context.Background(), vid, r, file, opt...)
+}
 
+// UploadPictureWithContext method is the same as UploadPicture, with the addition of the ability to pass a context.
+func (s *VideosService) UploadPictureWithContext(ctx context.Context, vid int, r *PicturesRequest, file *os.File, opt ...UploadOption) (*Pictures, *Response, error) {
 	stat, err := file.Stat()
 	if err != nil {
 		return nil, nil, err
@@ -171,20 +199,79 @@ func (s *VideosService) UploadPicture(vid int, r *PicturesRequest, file *os.File
 		return nil, nil, errors.New("the video file can't be a directory")
 	}
 
-	req, err := http.NewRequest("PUT", pictures.Link, file)
-	if err != nil {
+	return s.UploadPictureFromReaderWithContext(ctx, vid, r, file, stat.Size(), opt...)
+}
+
+// UploadPictureFromReader method is the same as UploadPicture, but reads size bytes of the picture from reader.
+// If reader implements io.ReaderAt, the upload can be retried.
+func (s *VideosService) UploadPictureFromReader(vid int, r *PicturesRequest, reader io.Reader, size int64, opt ...UploadOption) (*Pictures, *Response, error) {
+	return s.UploadPictureFromReaderWithContext(context.Background(), vid, r, reader, size, opt...)
+}
+
+// UploadPictureFromReaderWithContext method is the same as UploadPictureFromReader, with the addition of the ability to pass a context.
+func (s *VideosService) UploadPictureFromReaderWithContext(ctx context.Context, vid int, r *PicturesRequest, reader io.Reader, size int64, opt ...UploadOption) (*Pictures, *Response, error) {
+	if err := checkPictureOptions(opt); err != nil {
 		return nil, nil, err
 	}
 
-	_, err = s.client.Do(req, nil)
+	pictures, _, err := s.CreatePicturesWithContext(ctx, vid, r)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	pictures, resp, err := s.GetPictures(vid, pictures.GetID())
+	if err := uploadPicture(ctx, s.client, pictures.Link, reader, size, opt); err != nil {
+		return nil, nil, err
+	}
+
+	pictures, resp, err := s.GetPicturesWithContext(ctx, vid, pictures.GetID())
 	if err != nil {
 		return nil, nil, err
 	}
 
 	return pictures, resp, err
 }
+
+// checkPictureOptions returns an error for the options which don't apply to
+// picture uploads: only OptProgress and OptBandwidth do.
+func checkPictureOptions(opt []UploadOption) error {
+	for _, o := range opt {
+		switch o.(type) {
+		case OptProgress, OptBandwidth:
+		default:
+			return fmt.Errorf("%T is not supported by picture uploads", o)
+		}
+	}
+
+	return nil
+}
+
+// uploadPicture sends size bytes of a picture read from reader to its upload link.
+func uploadPicture(ctx context.Context, c *Client, link string, reader io.Reader, size int64, opt []UploadOption) error {
+	opts := c.uploadOptions(opt)
+	ra, isReaderAt := reader.(io.ReaderAt)
+
+	progress := newProgressTracker(opts.progress, size, 0)
+	body := func(r io.Reader) io.Reader {
+		return &progressReader{r: opts.bandwidth.reader(ctx, r), tracker: progress}
+	}
+
+	var r io.Reader = io.LimitReader(reader, size)
+	if isReaderAt {
+		r = io.NewSectionReader(ra, 0, size)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", link, body(r))
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = size
+	if isReaderAt {
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(body(io.NewSectionReader(ra, 0, size))), nil
+		}
+	}
+
+	_, err = c.Do(req, nil)
+	return err
+}
@@ -1,6 +1,9 @@
 package vimeo
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type dataListPreset struct {
 	Data []*Preset `json:"data,omitempty"`
@@ -17,6 +20,11 @@ type Preset struct {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#get_embed_presets
 func (s *UsersService) ListPreset(uid string, opt ...CallOption) ([]*Preset, *Response, error) {
+	return s.ListPresetWithContext(context.Background(), uid, opt...)
+}
+
+// ListPresetWithContext method is the same as ListPreset, with the addition of the ability to pass a context.
+func (s *UsersService) ListPresetWithContext(ctx context.Context, uid string, opt ...CallOption) ([]*Preset, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/presets")
@@ -29,7 +37,7 @@ func (s *UsersService) ListPreset(uid string, opt ...CallOption) ([]*Preset, *Re
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -50,6 +58,11 @@ func (s *UsersService) ListPreset(uid string, opt ...CallOption) ([]*Preset, *Re
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#get_embed_preset
 func (s *UsersService) GetPreset(uid string, p int, opt ...CallOption) (*Preset, *Response, error) {
+	return s.GetPresetWithContext(context.Background(), uid, p, opt...)
+}
+
+// GetPresetWithContext method is the same as GetPreset, with the addition of the ability to pass a context.
+func (s *UsersService) GetPresetWithContext(ctx context.Context, uid string, p int, opt ...CallOption) (*Preset, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/presets/%d", p)
@@ -62,7 +75,7 @@ func (s *UsersService) GetPreset(uid string, p int, opt ...CallOption) (*Preset,
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -81,6 +94,11 @@ func (s *UsersService) GetPreset(uid string, p int, opt ...CallOption) (*Preset,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#edit_embed_preset
 func (s *UsersService) PresetListVideo(uid string, p int, opt ...CallOption) ([]*Video, *Response, error) {
+	return s.PresetListVideoWithContext(context.Background(), uid, p, opt...)
+}
+
+// PresetListVideoWithContext method is the same as PresetListVideo, with the addition of the ability to pass a context.
+func (s *UsersService) PresetListVideoWithContext(ctx context.Context, uid string, p int, opt ...CallOption) ([]*Video, *Response, error) {
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/presets/%d/videos", p)
@@ -88,7 +106,7 @@ func (s *UsersService) PresetListVideo(uid string, p int, opt ...CallOption) ([]
 		u = fmt.Sprintf("users/%s/presets/%d/videos", uid, p)
 	}
 
-	videos, resp, err := listVideo(s.client, u, opt...)
+	videos, resp, err := listVideo(ctx, s.client, u, opt...)
 
 	return videos, resp, err
 }
@@ -1,11 +1,18 @@
 package vimeo
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestVideo_GetID(t *testing.T) {
@@ -60,6 +67,34 @@ func TestVideosService_Get(t *testing.T) {
 	}
 }
 
+func TestVideosService_GetWithContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	video, _, err := client.Videos.GetWithContext(context.Background(), 1)
+	if err != nil {
+		t.Errorf("Videos.GetWithContext returned unexpected error: %v", err)
+	}
+
+	want := &Video{Name: "Test"}
+	if !reflect.DeepEqual(video, want) {
+		t.Errorf("Videos.GetWithContext returned %+v, want %+v", video, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err = client.Videos.GetWithContext(ctx, 1)
+	if err != context.Canceled {
+		t.Errorf("Videos.GetWithContext returned error %v, want %v", err, context.Canceled)
+	}
+}
+
 func TestVideosService_Edit(t *testing.T) {
 	setup()
 	defer teardown()
@@ -133,6 +168,26 @@ func TestVideosService_ListCategory(t *testing.T) {
 	}
 }
 
+func TestVideosService_ListAlbum(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/albums", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	albums, _, err := client.Videos.ListAlbum(1)
+	if err != nil {
+		t.Errorf("Videos.ListAlbum returned unexpected error: %v", err)
+	}
+
+	want := []*Album{{Name: "Test"}}
+	if !reflect.DeepEqual(albums, want) {
+		t.Errorf("Videos.ListAlbum returned %+v, want %+v", albums, want)
+	}
+}
+
 func TestVideosService_ListComment(t *testing.T) {
 	setup()
 	defer teardown()
@@ -503,6 +558,44 @@ func TestVideosService_CreatePictures(t *testing.T) {
 	}
 }
 
+func TestVideosService_UploadPictureFromReader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := []byte("picture")
+
+	mux.HandleFunc("/videos/1/pictures", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprintf(w, `{"uri": "/videos/1/pictures/2", "link": "%s/picture"}`, server.URL)
+	})
+
+	mux.HandleFunc("/picture", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		if r.ContentLength != int64(len(content)) {
+			t.Errorf("Request Content-Length is %v, want %v", r.ContentLength, len(content))
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if !bytes.Equal(body, content) {
+			t.Errorf("Uploaded picture is %q, want %q", body, content)
+		}
+	})
+
+	mux.HandleFunc("/videos/1/pictures/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uri": "/videos/1/pictures/2", "active": true}`)
+	})
+
+	pictures, _, err := client.Videos.UploadPictureFromReader(1, &PicturesRequest{Active: true}, bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("Videos.UploadPictureFromReader returned unexpected error: %v", err)
+	}
+
+	want := &Pictures{URI: "/videos/1/pictures/2", Active: true}
+	if !reflect.DeepEqual(pictures, want) {
+		t.Errorf("Videos.UploadPictureFromReader returned %+v, want %+v", pictures, want)
+	}
+}
+
 func TestVideosService_GetPictures(t *testing.T) {
 	setup()
 	defer teardown()
@@ -919,6 +1012,95 @@ func TestVideosService_DeleteTextTrack(t *testing.T) {
 	}
 }
 
+func TestVideosService_ListVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/versions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"uri": "/videos/1/versions/2", "active": true}, {"uri": "/videos/1/versions/1"}]}`)
+	})
+
+	versions, _, err := client.Videos.ListVersion(1)
+	if err != nil {
+		t.Errorf("Videos.ListVersion returned unexpected error: %v", err)
+	}
+
+	want := []*Version{{URI: "/videos/1/versions/2", Active: true}, {URI: "/videos/1/versions/1"}}
+	if !reflect.DeepEqual(versions, want) {
+		t.Errorf("Videos.ListVersion returned %+v, want %+v", versions, want)
+	}
+}
+
+func TestVideosService_GetVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uri": "/videos/1/versions/1", "filename": "video.mp4", "filesize": 100}`)
+	})
+
+	version, _, err := client.Videos.GetVersion(1, 1)
+	if err != nil {
+		t.Errorf("Videos.GetVersion returned unexpected error: %v", err)
+	}
+
+	want := &Version{URI: "/videos/1/versions/1", FileName: "video.mp4", FileSize: 100}
+	if !reflect.DeepEqual(version, want) {
+		t.Errorf("Videos.GetVersion returned %+v, want %+v", version, want)
+	}
+
+	if version.GetID() != 1 {
+		t.Errorf("Version.GetID returned %+v, want %+v", version.GetID(), 1)
+	}
+}
+
+func TestVideosService_SetActiveVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		v := &VersionRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("Videos.SetActiveVersion returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "PATCH")
+		want := &VersionRequest{Active: true}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Videos.SetActiveVersion body is %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"uri": "/videos/1/versions/1", "active": true}`)
+	})
+
+	version, _, err := client.Videos.SetActiveVersion(1, 1)
+	if err != nil {
+		t.Errorf("Videos.SetActiveVersion returned unexpected error: %v", err)
+	}
+
+	want := &Version{URI: "/videos/1/versions/1", Active: true}
+	if !reflect.DeepEqual(version, want) {
+		t.Errorf("Videos.SetActiveVersion returned %+v, want %+v", version, want)
+	}
+}
+
+func TestVideosService_DeleteVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Videos.DeleteVersion(1, 1)
+	if err != nil {
+		t.Errorf("Videos.DeleteVersion returned unexpected error: %v", err)
+	}
+}
+
 func TestVideosService_ListRelatedVideo(t *testing.T) {
 	setup()
 	defer teardown()
@@ -967,7 +1149,7 @@ func TestVideosService_getUploadVideo(t *testing.T) {
 		}
 	})
 
-	uploadVideo, _, err := getUploadVideo(client, "POST", "/me/videos", input)
+	uploadVideo, _, err := getUploadVideo(context.Background(), client, "POST", "/me/videos", input)
 	if err != nil {
 		t.Errorf("Videos.getUploadVideo returned unexpected error: %v", err)
 	}
@@ -1010,7 +1192,7 @@ func TestVideosService_uploadVideoByURL(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := uploadVideoByURL(client, "/me/videos", videoURL)
+	video, _, err := uploadVideoByURL(context.Background(), client, "/me/videos", videoURL)
 	if err != nil {
 		t.Errorf("Videos.Get returned unexpected error: %v", err)
 	}
@@ -1020,3 +1202,415 @@ func TestVideosService_uploadVideoByURL(t *testing.T) {
 		t.Errorf("Videos.Get returned %+v, want %+v", video, want)
 	}
 }
+
+func TestVideosService_uploadVideoByURL_metadata(t *testing.T) {
+	setup()
+	defer teardown()
+
+	videoURL := "http://video.com/1.mp4"
+	input := &UploadVideoRequest{
+		Name:        "Name",
+		Description: "Description",
+		Privacy:     &Privacy{View: "password"},
+		Password:    "secret",
+		FolderURI:   "/users/1/projects/2",
+		Upload: &Upload{
+			Approach: "pull",
+			Link:     videoURL,
+		},
+	}
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		v := &UploadVideoRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Videos.uploadVideoByURL body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"uri": "/videos/1"}`)
+	})
+
+	mux.HandleFunc("/videos/1/tags", func(w http.ResponseWriter, r *http.Request) {
+		var v []*Tag
+		json.NewDecoder(r.Body).Decode(&v)
+
+		testMethod(t, r, "PUT")
+		want := []*Tag{{Name: "a"}, {Name: "b"}}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Videos.uploadVideoByURL tags are %+v, want %+v", v, want)
+		}
+	})
+
+	_, _, err := uploadVideoByURL(context.Background(), client, "/me/videos", videoURL, OptMetadata{
+		Name:        "Name",
+		Description: "Description",
+		Privacy:     &Privacy{View: "password"},
+		Password:    "secret",
+		FolderURI:   "/users/1/projects/2",
+		Tags:        []string{"a", "b"},
+	})
+	if err != nil {
+		t.Errorf("Videos.uploadVideoByURL returned unexpected error: %v", err)
+	}
+}
+
+func TestVideosService_uploadVideoByURL_tagsFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1"}`)
+	})
+
+	mux.HandleFunc("/videos/1/tags", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "bad tag"}`, http.StatusBadRequest)
+	})
+
+	video, _, err := uploadVideoByURL(context.Background(), client, "/me/videos", "http://video.com/1.mp4", OptMetadata{
+		Tags: []string{"a"},
+	})
+
+	var tagsErr *TagsError
+	if !errors.As(err, &tagsErr) {
+		t.Fatalf("Videos.uploadVideoByURL returned %#v, want *TagsError", err)
+	}
+
+	want := &Video{URI: "/videos/1"}
+	if !reflect.DeepEqual(video, want) || !reflect.DeepEqual(tagsErr.Video, want) {
+		t.Errorf("Videos.uploadVideoByURL returned %+v, want %+v", video, want)
+	}
+}
+
+func testPollPolicy() *PollPolicy {
+	return &PollPolicy{MinInterval: time.Millisecond, MaxInterval: time.Millisecond}
+}
+
+func TestVideosService_WaitForTranscode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"fields": "uri,name,link,status,upload.status,upload.approach,transcode.status",
+		})
+
+		polls++
+		if polls < 3 {
+			fmt.Fprint(w, `{"uri": "/videos/1", "status": "transcoding", "upload": {"status": "complete"}, "transcode": {"status": "in_progress"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"uri": "/videos/1", "status": "available", "upload": {"status": "complete"}, "transcode": {"status": "complete"}}`)
+	})
+
+	result, _, err := client.Videos.WaitForTranscode(1, testPollPolicy())
+	if err != nil {
+		t.Fatalf("Videos.WaitForTranscode returned unexpected error: %v", err)
+	}
+
+	want := &Video{URI: "/videos/1", Status: "available", Upload: &Upload{Status: "complete"}, TransCode: &TransCode{Status: "complete"}}
+	if !reflect.DeepEqual(result.Video, want) {
+		t.Errorf("Videos.WaitForTranscode returned %+v, want %+v", result.Video, want)
+	}
+
+	if result.Polls != 3 {
+		t.Errorf("Videos.WaitForTranscode polled %v times, want %v", result.Polls, 3)
+	}
+}
+
+func TestVideosService_WaitForTranscode_failed(t *testing.T) {
+	tests := []struct {
+		body            string
+		uploadFailed    bool
+		transcodeFailed bool
+	}{
+		{`{"uri": "/videos/1", "status": "uploading_error", "upload": {"status": "error"}}`, true, false},
+		{`{"uri": "/videos/1", "status": "quota_exceeded"}`, true, false},
+		{`{"uri": "/videos/1", "status": "transcoding", "transcode": {"status": "error"}}`, false, true},
+	}
+
+	for _, tt := range tests {
+		setup()
+
+		mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, tt.body)
+		})
+
+		_, _, err := client.Videos.WaitForTranscode(1, testPollPolicy())
+		if _, ok := err.(*TranscodeError); !ok {
+			t.Errorf("Videos.WaitForTranscode returned %#v, want *TranscodeError", err)
+		}
+
+		if IsUploadFailed(err) != tt.uploadFailed || IsTranscodeFailed(err) != tt.transcodeFailed {
+			t.Errorf("Videos.WaitForTranscode returned %v for %s, want upload failed %v, transcode failed %v", err, tt.body, tt.uploadFailed, tt.transcodeFailed)
+		}
+
+		teardown()
+	}
+}
+
+func TestVideosService_WatchTranscode_pull(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"uri": "/videos/1", "status": "uploading", "upload": {"approach": "pull", "status": "in_progress"}}`)
+	})
+
+	polls := 0
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls < 2 {
+			fmt.Fprint(w, `{"uri": "/videos/1", "status": "uploading", "upload": {"approach": "pull", "status": "in_progress"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"uri": "/videos/1", "status": "uploading_error", "upload": {"approach": "pull", "status": "error"}}`)
+	})
+
+	video, _, err := client.Users.UploadVideoByURL("", "http://video.com/1.mp4")
+	if err != nil {
+		t.Fatalf("Users.UploadVideoByURL returned unexpected error: %v", err)
+	}
+
+	done := make(chan error)
+	client.Videos.WatchTranscode(video.GetID(), testPollPolicy(), func(result *TranscodeResult, err error) {
+		done <- err
+	})
+
+	err = <-done
+	if !IsFetchFailed(err) || !IsUploadFailed(err) || IsTranscodeFailed(err) {
+		t.Errorf("Videos.WatchTranscode returned %v, want a fetch failure", err)
+	}
+}
+
+func TestTranscodeError_fetchFailed(t *testing.T) {
+	tests := []struct {
+		video *Video
+		want  bool
+	}{
+		{&Video{Status: VideoStatusUploadingError, Upload: &Upload{Approach: "pull", Status: "error"}}, true},
+		{&Video{Status: VideoStatusUploadingError, Upload: &Upload{Approach: "tus", Status: "error"}}, false},
+		{&Video{Status: VideoStatusTranscodingError, Upload: &Upload{Approach: "pull", Status: "complete"}}, false},
+	}
+
+	for _, tt := range tests {
+		_, err := videoTranscoded(tt.video)
+		if got := IsFetchFailed(err); got != tt.want {
+			t.Errorf("IsFetchFailed(%v) returned %v, want %v", err, got, tt.want)
+		}
+	}
+}
+
+func TestVideosService_WaitForTranscode_deadline(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1", "status": "transcoding", "transcode": {"status": "in_progress"}}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.Videos.WaitForTranscodeWithContext(ctx, 1, testPollPolicy())
+	if err != context.DeadlineExceeded {
+		t.Errorf("Videos.WaitForTranscodeWithContext returned %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+// downloadVideo returns the JSON of a video with sd, hd and source renditions of content at the given links.
+func downloadVideo(content []byte, link string) string {
+	sum := testChecksum(content)
+	return fmt.Sprintf(`{"uri": "/videos/1", "download": [
+		{"quality": "sd", "height": 360, "size": 10, "link": "%[1]s/sd"},
+		{"quality": "source", "height": 1080, "size": %[2]d, "md5": "%[3]s", "link": "%[1]s/source%[4]s"},
+		{"quality": "hd", "height": 720, "size": %[2]d, "md5": "%[3]s", "link": "%[1]s/hd"}
+	]}`, server.URL, sum.Size, sum.MD5, link)
+}
+
+func TestVideosService_Download(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, downloadVideo(content, ""))
+	})
+
+	mux.HandleFunc("/hd", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(content)
+	})
+
+	var buf bytes.Buffer
+	download, _, err := client.Videos.Download(1, &buf, OptMaxHeight(720))
+	if err != nil {
+		t.Fatalf("Videos.Download returned unexpected error: %v", err)
+	}
+
+	if download.Quality != "hd" {
+		t.Errorf("Videos.Download downloaded %v, want %v", download.Quality, "hd")
+	}
+
+	if !bytes.Equal(buf.Bytes(), content) {
+		t.Errorf("Downloaded data is %q, want %q", buf.Bytes(), content)
+	}
+
+	_, _, err = client.Videos.Download(1, &buf, OptQuality("mobile"))
+	if err != ErrNoDownload {
+		t.Errorf("Videos.Download returned %v, want %v", err, ErrNoDownload)
+	}
+}
+
+func TestVideosService_Download_middleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, downloadVideo(content, ""))
+	})
+
+	mux.HandleFunc("/source", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(content)
+	})
+
+	var paths []string
+	client.Config.Middleware = []Middleware{func(next Handler) Handler {
+		return func(req *http.Request, v interface{}) (*Response, error) {
+			resp, err := next(req, v)
+			paths = append(paths, fmt.Sprintf("%s %d", req.URL.Path, resp.StatusCode))
+			return resp, err
+		}
+	}}
+
+	var buf bytes.Buffer
+	if _, _, err := client.Videos.Download(1, &buf, OptBandwidth(1<<20)); err != nil {
+		t.Fatalf("Videos.Download returned unexpected error: %v", err)
+	}
+
+	want := []string{"/videos/1 200", "/source 200"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Middleware saw %v, want %v", paths, want)
+	}
+}
+
+func TestVideosService_Download_resume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+
+	fetches := 0
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		fmt.Fprint(w, downloadVideo(content, fmt.Sprintf("?token=%d", fetches)))
+	})
+
+	var ranges []string
+	mux.HandleFunc("/source", func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		switch {
+		case len(ranges) == 1:
+			// The connection is lost after half of the rendition.
+			w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+			w.Write(content[:50])
+		case r.URL.Query().Get("token") == "1":
+			// The link has expired.
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			http.ServeContent(w, r, "source.mp4", time.Time{}, bytes.NewReader(content))
+		}
+	})
+
+	var buf bytes.Buffer
+	download, _, err := client.Videos.Download(1, &buf)
+	if err != nil {
+		t.Fatalf("Videos.Download returned unexpected error: %v", err)
+	}
+
+	if download.Quality != "source" {
+		t.Errorf("Videos.Download downloaded %v, want %v", download.Quality, "source")
+	}
+
+	if !bytes.Equal(buf.Bytes(), content) {
+		t.Errorf("Downloaded data is %q, want %q", buf.Bytes(), content)
+	}
+
+	want := []string{"", "bytes=50-", "bytes=50-"}
+	if !reflect.DeepEqual(ranges, want) {
+		t.Errorf("Videos.Download requested ranges %q, want %q", ranges, want)
+	}
+
+	if fetches != 2 {
+		t.Errorf("Videos.Download fetched the video %d times, want %d", fetches, 2)
+	}
+}
+
+func TestVideosService_DownloadFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, downloadVideo(content, ""))
+	})
+
+	var ranges []string
+	mux.HandleFunc("/source", func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		http.ServeContent(w, r, "source.mp4", time.Time{}, bytes.NewReader(content))
+	})
+
+	// A previous process downloaded the start of the rendition.
+	name := filepath.Join(t.TempDir(), "source.mp4")
+	if err := os.WriteFile(name, content[:30], 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err := client.Videos.DownloadFile(1, name)
+	if err != nil {
+		t.Fatalf("Videos.DownloadFile returned unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(data, content) {
+		t.Errorf("Downloaded file is %q, want %q", data, content)
+	}
+
+	want := []string{"bytes=30-"}
+	if !reflect.DeepEqual(ranges, want) {
+		t.Errorf("Videos.DownloadFile requested ranges %q, want %q", ranges, want)
+	}
+}
+
+func TestVideosService_Download_verificationFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, downloadVideo(content, ""))
+	})
+
+	mux.HandleFunc("/source", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(bytes.ToUpper(bytes.Repeat([]byte("abcdefghij"), 10)))
+	})
+
+	_, _, err := client.Videos.Download(1, ioutil.Discard)
+	if !IsVerificationFailed(err) {
+		t.Errorf("Videos.Download returned %v, want a verification failure", err)
+	}
+}
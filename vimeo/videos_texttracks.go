@@ -1,6 +1,9 @@
 package vimeo
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type dataListTextTrack struct {
 	Data []*TextTrack `json:"data,omitempty"`
@@ -26,12 +29,17 @@ type TextTrackRequest struct {
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_text_tracks
 func (s *VideosService) ListTextTrack(vid int, opt ...CallOption) ([]*TextTrack, *Response, error) {
+	return s.ListTextTrackWithContext(context.Background(), vid, opt...)
+}
+
+// ListTextTrackWithContext method is the same as ListTextTrack, with the addition of the ability to pass a context.
+func (s *VideosService) ListTextTrackWithContext(ctx context.Context, vid int, opt ...CallOption) ([]*TextTrack, *Response, error) {
 	u, err := addOptions(fmt.Sprintf("/videos/%d/texttracks", vid), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -52,8 +60,13 @@ func (s *VideosService) ListTextTrack(vid int, opt ...CallOption) ([]*TextTrack,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#create_text_track
 func (s *VideosService) AddTextTrack(vid int, r *TextTrackRequest) (*TextTrack, *Response, error) {
+	return s.AddTextTrackWithContext(context.Background(), vid, r)
+}
+
+// AddTextTrackWithContext method is the same as AddTextTrack, with the addition of the ability to pass a context.
+func (s *VideosService) AddTextTrackWithContext(ctx context.Context, vid int, r *TextTrackRequest) (*TextTrack, *Response, error) {
 	u := fmt.Sprintf("/videos/%d/texttracks", vid)
-	req, err := s.client.NewRequest("POST", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -72,12 +85,17 @@ func (s *VideosService) AddTextTrack(vid int, r *TextTrackRequest) (*TextTrack,
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_text_track
 func (s *VideosService) GetTextTrack(vid int, tid int, opt ...CallOption) (*TextTrack, *Response, error) {
+	return s.GetTextTrackWithContext(context.Background(), vid, tid, opt...)
+}
+
+// GetTextTrackWithContext method is the same as GetTextTrack, with the addition of the ability to pass a context.
+func (s *VideosService) GetTextTrackWithContext(ctx context.Context, vid int, tid int, opt ...CallOption) (*TextTrack, *Response, error) {
 	u, err := addOptions(fmt.Sprintf("videos/%d/texttracks/%d", vid, tid), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -96,8 +114,13 @@ func (s *VideosService) GetTextTrack(vid int, tid int, opt ...CallOption) (*Text
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#edit_text_track
 func (s *VideosService) EditTextTrack(vid int, tid int, r *TextTrackRequest) (*TextTrack, *Response, error) {
+	return s.EditTextTrackWithContext(context.Background(), vid, tid, r)
+}
+
+// EditTextTrackWithContext method is the same as EditTextTrack, with the addition of the ability to pass a context.
+func (s *VideosService) EditTextTrackWithContext(ctx context.Context, vid int, tid int, r *TextTrackRequest) (*TextTrack, *Response, error) {
 	u := fmt.Sprintf("videos/%d/texttracks/%d", vid, tid)
-	req, err := s.client.NewRequest("PATCH", u, r)
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -115,8 +138,13 @@ func (s *VideosService) EditTextTrack(vid int, tid int, r *TextTrackRequest) (*T
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_text_track
 func (s *VideosService) DeleteTextTrack(vid int, tid int) (*Response, error) {
+	return s.DeleteTextTrackWithContext(context.Background(), vid, tid)
+}
+
+// DeleteTextTrackWithContext method is the same as DeleteTextTrack, with the addition of the ability to pass a context.
+func (s *VideosService) DeleteTextTrackWithContext(ctx context.Context, vid int, tid int) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/texttracks/%d", vid, tid)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,188 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Statuses of a video.
+const (
+	VideoStatusAvailable         = "available"
+	VideoStatusUploading         = "uploading"
+	VideoStatusUploadingError    = "uploading_error"
+	VideoStatusTranscodeStarting = "transcode_starting"
+	VideoStatusTranscoding       = "transcoding"
+	VideoStatusTranscodingError  = "transcoding_error"
+	VideoStatusQuotaExceeded     = "quota_exceeded"
+	VideoStatusTotalCapExceeded  = "total_cap_exceeded"
+)
+
+// Statuses of the upload and transcode of a video.
+const (
+	statusComplete = "complete"
+	statusError    = "error"
+)
+
+// transcodeFields are the fields of the video fetched by WaitForTranscode.
+var transcodeFields = OptFields{"uri", "name", "link", "status", "upload.status", "upload.approach", "transcode.status"}
+
+// PollPolicy controls how often WaitForTranscode fetches the video.
+// The interval starts at MinInterval and grows by Multiplier up to MaxInterval.
+type PollPolicy struct {
+	MinInterval time.Duration
+	MaxInterval time.Duration
+	Multiplier  float64
+}
+
+// DefaultPollPolicy returns the poll policy used when none is given.
+func DefaultPollPolicy() *PollPolicy {
+	return &PollPolicy{
+		MinInterval: 5 * time.Second,
+		MaxInterval: time.Minute,
+		Multiplier:  1.5,
+	}
+}
+
+// interval returns the delay before the given poll, starting at 1.
+func (p *PollPolicy) interval(poll int) time.Duration {
+	multiplier := math.Max(p.Multiplier, 1)
+	d := float64(p.MinInterval) * math.Pow(multiplier, float64(poll-1))
+	if p.MaxInterval > 0 && d > float64(p.MaxInterval) {
+		d = float64(p.MaxInterval)
+	}
+
+	return time.Duration(d)
+}
+
+// TranscodeResult is the video whose transcode completed, as returned by WaitForTranscode.
+type TranscodeResult struct {
+	// Video holds the fields fetched while polling: URI, name, link, statuses and upload approach.
+	Video *Video
+
+	// Polls is the number of times the video was fetched.
+	Polls int
+
+	// Elapsed is the time spent waiting.
+	Elapsed time.Duration
+}
+
+// TranscodeError reports a video whose upload or transcode failed.
+// It matches ErrUploadFailed or ErrTranscodeFailed with errors.Is, and
+// ErrFetchFailed when Vimeo could not fetch the file of a pull upload.
+type TranscodeError struct {
+	Video *Video
+
+	// Status is the status of the video, such as uploading_error or quota_exceeded.
+	Status string
+}
+
+func (e *TranscodeError) Error() string {
+	return fmt.Sprintf("vimeo: video %v is %v", e.Video.URI, e.Status)
+}
+
+// Is reports whether the error matches ErrUploadFailed, ErrFetchFailed, ErrTranscodeFailed or ErrQuotaExceeded.
+func (e *TranscodeError) Is(target error) bool {
+	switch target {
+	case ErrFetchFailed:
+		return e.Status == VideoStatusUploadingError && e.Video.Upload != nil && e.Video.Upload.Approach == "pull"
+	case ErrQuotaExceeded:
+		return e.Status == VideoStatusQuotaExceeded || e.Status == VideoStatusTotalCapExceeded
+	case ErrTranscodeFailed:
+		return e.transcodeFailed()
+	case ErrUploadFailed:
+		return !e.transcodeFailed()
+	}
+
+	return false
+}
+
+func (e *TranscodeError) transcodeFailed() bool {
+	return e.Status == VideoStatusTranscodingError
+}
+
+// videoTranscoded reports whether the transcode of the video completed,
+// or the error if the upload or transcode failed.
+func videoTranscoded(v *Video) (bool, error) {
+	switch v.Status {
+	case VideoStatusUploadingError, VideoStatusTranscodingError, VideoStatusQuotaExceeded, VideoStatusTotalCapExceeded:
+		return false, &TranscodeError{Video: v, Status: v.Status}
+	}
+
+	if v.Upload != nil && v.Upload.Status == statusError {
+		return false, &TranscodeError{Video: v, Status: VideoStatusUploadingError}
+	}
+
+	if v.TransCode != nil {
+		switch v.TransCode.Status {
+		case statusError:
+			return false, &TranscodeError{Video: v, Status: VideoStatusTranscodingError}
+		case statusComplete:
+			return true, nil
+		}
+	}
+
+	return v.Status == VideoStatusAvailable, nil
+}
+
+// WaitForTranscode method polls the video until its transcode completes. It returns
+// a *TranscodeError when the upload or transcode fails. Passing a nil policy uses DefaultPollPolicy.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/upload/videos#checking-the-upload-status
+func (s *VideosService) WaitForTranscode(vid int, policy *PollPolicy) (*TranscodeResult, *Response, error) {
+	return s.WaitForTranscodeWithContext(context.Background(), vid, policy)
+}
+
+// WaitForTranscodeWithContext method is the same as WaitForTranscode, with the addition of the ability to pass a context.
+// It stops with the error of the context when the context is done.
+func (s *VideosService) WaitForTranscodeWithContext(ctx context.Context, vid int, policy *PollPolicy) (*TranscodeResult, *Response, error) {
+	if policy == nil {
+		policy = DefaultPollPolicy()
+	}
+
+	start := time.Now()
+	result := &TranscodeResult{}
+	for {
+		video, resp, err := s.GetWithContext(ctx, vid, transcodeFields)
+		if err != nil {
+			return nil, resp, err
+		}
+		result.Polls++
+
+		done, err := videoTranscoded(video)
+		if err != nil {
+			return nil, resp, err
+		}
+		if done {
+			result.Video = video
+			result.Elapsed = time.Since(start)
+			return result, resp, nil
+		}
+
+		timer := time.NewTimer(policy.interval(result.Polls))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// WatchTranscode method polls the video in the background, as WaitForTranscode,
+// and calls fn with the result once the transcode completes or fails.
+// It is convenient to track a video uploaded with UploadVideoByURL, which Vimeo fetches
+// asynchronously: if the file can't be fetched, the error matches ErrFetchFailed.
+func (s *VideosService) WatchTranscode(vid int, policy *PollPolicy, fn func(*TranscodeResult, error)) {
+	s.WatchTranscodeWithContext(context.Background(), vid, policy, fn)
+}
+
+// WatchTranscodeWithContext method is the same as WatchTranscode, with the addition of the ability to pass a context.
+// fn is also called with the error of the context once it is done.
+func (s *VideosService) WatchTranscodeWithContext(ctx context.Context, vid int, policy *PollPolicy, fn func(*TranscodeResult, error)) {
+	go func() {
+		result, _, err := s.WaitForTranscodeWithContext(ctx, vid, policy)
+		fn(result, err)
+	}()
+}
@@ -0,0 +1,265 @@
+package vimeo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type dataListVersion struct {
+	Data []*Version `json:"data,omitempty"`
+	pagination
+}
+
+// Version represents a version of the file of a video.
+type Version struct {
+	URI          string     `json:"uri,omitempty"`
+	FileName     string     `json:"filename,omitempty"`
+	FileSize     int64      `json:"filesize,omitempty"`
+	Duration     int        `json:"duration,omitempty"`
+	Active       bool       `json:"active"`
+	CreatedTime  time.Time  `json:"created_time,omitempty"`
+	ModifiedTime time.Time  `json:"modified_time,omitempty"`
+	Upload       *Upload    `json:"upload,omitempty"`
+	TransCode    *TransCode `json:"transcode,omitempty"`
+}
+
+// VersionRequest represents a request to edit a version.
+type VersionRequest struct {
+	FileName string `json:"filename,omitempty"`
+	Active   bool   `json:"active,omitempty"`
+}
+
+// UploadVersionRequest represents a request to create a version.
+type UploadVersionRequest struct {
+	FileName string  `json:"file_name,omitempty"`
+	Upload   *Upload `json:"upload,omitempty"`
+}
+
+// GetID returns the numeric identifier (ID) of the version.
+func (v Version) GetID() int {
+	l := strings.SplitN(v.URI, "/", -1)
+	ID, _ := strconv.Atoi(l[len(l)-1])
+	return ID
+}
+
+func getVersion(ctx context.Context, c *Client, url string, opt ...CallOption) (*Version, *Response, error) {
+	u, err := addOptions(url, opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := &Version{}
+
+	resp, err := c.Do(req, version)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return version, resp, err
+}
+
+func replaceFile(ctx context.Context, c *Client, vid int, name string, r io.Reader, size int64, opt ...UploadOption) (*Version, *Response, error) {
+	opts := c.uploadOptions(opt)
+	target := fmt.Sprintf("videos/%d/versions", vid)
+
+	uri, err := uploadFromReader(ctx, c, opts, target, r, size, func(ctx context.Context) (string, string, error) {
+		reqUpload := &UploadVersionRequest{
+			FileName: name,
+			Upload: &Upload{
+				Approach: "tus",
+				Size:     size,
+			},
+		}
+
+		req, err := c.NewRequestWithContext(ctx, "POST", target, reqUpload)
+		if err != nil {
+			return "", "", err
+		}
+
+		version := &Version{}
+		if _, err := c.Do(req, version); err != nil {
+			return "", "", err
+		}
+
+		if version.Upload == nil || version.Upload.UploadLink == "" {
+			return "", "", errors.New("the created version has no upload link")
+		}
+
+		return version.URI, version.Upload.UploadLink, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("videos/%d/versions/%d", vid, Version{URI: uri}.GetID())
+	version, resp, err := getVersion(ctx, c, u)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	sum, err := opts.checksumOf(r, size)
+	if err != nil {
+		return version, resp, err
+	}
+
+	if err := verifyVersion(version, sum); err != nil {
+		return version, resp, err
+	}
+
+	return version, resp, nil
+}
+
+// ReplaceFile method adds a version to the specified video and returns it.
+// The new version becomes the active one once uploaded.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/videos#create_video_version
+func (s *VideosService) ReplaceFile(vid int, file *os.File, opt ...UploadOption) (*Version, *Response, error) {
+	return s.ReplaceFileWithContext(context.Background(), vid, file, opt...)
+}
+
+// ReplaceFileWithContext method is the same as ReplaceFile, with the addition of the ability to pass a context.
+func (s *VideosService) ReplaceFileWithContext(ctx context.Context, vid int, file *os.File, opt ...UploadOption) (*Version, *Response, error) {
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if stat.IsDir() {
+		return nil, nil, errors.New("the video file can't be a directory")
+	}
+
+	opt = append([]UploadOption{OptSessionKey(fileSessionKey(file, stat))}, opt...)
+	version, resp, err := replaceFile(ctx, s.client, vid, filepath.Base(file.Name()), file, stat.Size(), opt...)
+
+	return version, resp, err
+}
+
+// ReplaceFileFromReader method is the same as ReplaceFile, but reads size bytes of the new version from r.
+// The name is the file name of the new version, such as video.mp4.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/videos#create_video_version
+func (s *VideosService) ReplaceFileFromReader(vid int, name string, r io.Reader, size int64, opt ...UploadOption) (*Version, *Response, error) {
+	return s.ReplaceFileFromReaderWithContext(context.Background(), vid, name, r, size, opt...)
+}
+
+// ReplaceFileFromReaderWithContext method is the same as ReplaceFileFromReader, with the addition of the ability to pass a context.
+func (s *VideosService) ReplaceFileFromReaderWithContext(ctx context.Context, vid int, name string, r io.Reader, size int64, opt ...UploadOption) (*Version, *Response, error) {
+	version, resp, err := replaceFile(ctx, s.client, vid, name, r, size, opt...)
+
+	return version, resp, err
+}
+
+// ListVersion method returns all the versions of the specified video.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_versions
+func (s *VideosService) ListVersion(vid int, opt ...CallOption) ([]*Version, *Response, error) {
+	return s.ListVersionWithContext(context.Background(), vid, opt...)
+}
+
+// ListVersionWithContext method is the same as ListVersion, with the addition of the ability to pass a context.
+func (s *VideosService) ListVersionWithContext(ctx context.Context, vid int, opt ...CallOption) ([]*Version, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("videos/%d/versions", vid), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	versions := &dataListVersion{}
+
+	resp, err := s.client.Do(req, versions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(versions)
+
+	return versions.Data, resp, err
+}
+
+// GetVersion method returns a single version of the specified video.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_version
+func (s *VideosService) GetVersion(vid int, versionID int, opt ...CallOption) (*Version, *Response, error) {
+	return s.GetVersionWithContext(context.Background(), vid, versionID, opt...)
+}
+
+// GetVersionWithContext method is the same as GetVersion, with the addition of the ability to pass a context.
+func (s *VideosService) GetVersionWithContext(ctx context.Context, vid int, versionID int, opt ...CallOption) (*Version, *Response, error) {
+	u := fmt.Sprintf("videos/%d/versions/%d", vid, versionID)
+	version, resp, err := getVersion(ctx, s.client, u, opt...)
+
+	return version, resp, err
+}
+
+// EditVersion method edits the specified version of a video.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/videos#edit_video_version
+func (s *VideosService) EditVersion(vid int, versionID int, r *VersionRequest) (*Version, *Response, error) {
+	return s.EditVersionWithContext(context.Background(), vid, versionID, r)
+}
+
+// EditVersionWithContext method is the same as EditVersion, with the addition of the ability to pass a context.
+func (s *VideosService) EditVersionWithContext(ctx context.Context, vid int, versionID int, r *VersionRequest) (*Version, *Response, error) {
+	u := fmt.Sprintf("videos/%d/versions/%d", vid, versionID)
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := &Version{}
+	resp, err := s.client.Do(req, version)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return version, resp, nil
+}
+
+// SetActiveVersion method makes the specified version the active one of the video,
+// for example to roll back to the previous version after ReplaceFile.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/videos#edit_video_version
+func (s *VideosService) SetActiveVersion(vid int, versionID int) (*Version, *Response, error) {
+	return s.SetActiveVersionWithContext(context.Background(), vid, versionID)
+}
+
+// SetActiveVersionWithContext method is the same as SetActiveVersion, with the addition of the ability to pass a context.
+func (s *VideosService) SetActiveVersionWithContext(ctx context.Context, vid int, versionID int) (*Version, *Response, error) {
+	version, resp, err := s.EditVersionWithContext(ctx, vid, versionID, &VersionRequest{Active: true})
+
+	return version, resp, err
+}
+
+// DeleteVersion method deletes the specified version of a video.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_video_version
+func (s *VideosService) DeleteVersion(vid int, versionID int) (*Response, error) {
+	return s.DeleteVersionWithContext(context.Background(), vid, versionID)
+}
+
+// DeleteVersionWithContext method is the same as DeleteVersion, with the addition of the ability to pass a context.
+func (s *VideosService) DeleteVersionWithContext(ctx context.Context, vid int, versionID int) (*Response, error) {
+	u := fmt.Sprintf("videos/%d/versions/%d", vid, versionID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
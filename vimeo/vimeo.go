@@ -2,6 +2,7 @@ package vimeo
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -41,9 +42,13 @@ type Client struct {
 	Channels        *ChannelsService
 	ContentRatings  *ContentRatingsService
 	CreativeCommons *CreativeCommonsService
+	Folders         *FoldersService
 	Groups          *GroupsService
 	Languages       *LanguagesService
+	LiveEvents      *LiveEventsService
+	OnDemand        *OnDemandService
 	Tags            *TagsService
+	Teams           *TeamsService
 	Videos          *VideosService
 	Users           *UsersService
 }
@@ -72,9 +77,13 @@ func NewClient(httpClient *http.Client, config *Config) *Client {
 	c.Channels = &ChannelsService{client: c}
 	c.ContentRatings = &ContentRatingsService{client: c}
 	c.CreativeCommons = &CreativeCommonsService{client: c}
+	c.Folders = &FoldersService{client: c}
 	c.Groups = &GroupsService{client: c}
 	c.Languages = &LanguagesService{client: c}
+	c.LiveEvents = &LiveEventsService{client: c}
+	c.OnDemand = &OnDemandService{client: c}
 	c.Tags = &TagsService{client: c}
+	c.Teams = &TeamsService{client: c}
 	c.Videos = &VideosService{client: c}
 	c.Users = &UsersService{client: c}
 	return c
@@ -87,6 +96,12 @@ func (c *Client) Client() *http.Client {
 
 // NewRequest creates an API request.
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, urlStr, body)
+}
+
+// NewRequestWithContext creates an API request bound to the given context.
+// A canceled context aborts the request once it is sent by Do.
+func (c *Client) NewRequestWithContext(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -103,7 +118,7 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 		}
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -124,9 +139,44 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
+// Failed requests are retried according to Config.Retry. The request context is honored:
+// if it is canceled or its deadline is exceeded, the context error is returned.
+// The call goes through the Config.Middleware chain.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.client.Do(req)
+	return c.chain(c.do)(req, v)
+}
+
+// chain wraps the handler in the Config.Middleware chain.
+func (c *Client) chain(h Handler) Handler {
+	if c.Config != nil {
+		for i := len(c.Config.Middleware) - 1; i >= 0; i-- {
+			h = c.Config.Middleware[i](h)
+		}
+	}
+
+	return h
+}
+
+// do is the Handler at the end of the middleware chain.
+func (c *Client) do(req *http.Request, v interface{}) (response *Response, err error) {
+	var body *bodyRecorder
+	if c.logger() != nil {
+		start := time.Now()
+		defer func() {
+			c.logCall(req, response, err, time.Since(start), body)
+		}()
+	}
+
+	resp, attempts, err := c.send(req)
 	if err != nil {
+		// If we got an error, and the context has been canceled,
+		// the context's error is probably more useful.
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		default:
+		}
+
 		return nil, err
 	}
 
@@ -135,9 +185,15 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 		resp.Body.Close()
 	}()
 
-	response := newResponse(resp)
+	if c.logger() != nil && c.Config.LogBodies {
+		body = &bodyRecorder{ReadCloser: resp.Body, contentType: resp.Header.Get("Content-Type")}
+		resp.Body = body
+	}
 
-	err = CheckResponse(resp)
+	response = newResponse(resp)
+	response.Attempts = attempts
+
+	err = c.checkResponse(resp)
 	if err != nil {
 		return response, err
 	}
@@ -159,6 +215,59 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 	return response, err
 }
 
+// send sends the request, retrying it according to the retry policy of the client.
+// Requests to the API are paced by the rate limiter of the client, if any.
+// It returns the last response along with the number of attempts made.
+func (c *Client) send(req *http.Request) (*http.Response, int, error) {
+	var policy *RetryPolicy
+	var limiter *RateLimiter
+	if c.Config != nil {
+		policy = c.Config.Retry
+		if req.URL.Host == c.BaseURL.Host {
+			limiter = c.Config.RateLimiter
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		if limiter != nil {
+			if err := limiter.wait(req.Context()); err != nil {
+				return nil, attempt, err
+			}
+		}
+
+		resp, err := c.client.Do(req)
+		if limiter != nil && resp != nil {
+			limiter.update(parseRate(resp))
+		}
+
+		wait, ok := policy.retry(req, resp, err, attempt)
+		if !ok {
+			return resp, attempt, err
+		}
+
+		if resp != nil {
+			io.CopyN(ioutil.Discard, resp.Body, 512) // nolint: errcheck
+			resp.Body.Close()
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, attempt, err
+			}
+			req.Body = body
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, attempt, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
 type paginator interface {
 	GetPage() int
 	GetTotal() int
@@ -206,6 +315,12 @@ type Response struct {
 	PrevPage   string
 	FirstPage  string
 	LastPage   string
+
+	// Rate is the rate limit reported in the response headers.
+	Rate Rate
+
+	// Attempts is the number of times the request was sent, including retries.
+	Attempts int
 }
 
 func (r *Response) setPaging(p paginator) {
@@ -218,14 +333,63 @@ func (r *Response) setPaging(p paginator) {
 // ErrorResponse is a Vimeo error response. This wraps the standard http.Response.
 // Provides access error message returned Vimeo.
 type ErrorResponse struct {
-	Response *http.Response
-	Message  string `json:"error"`
+	Response          *http.Response
+	Message           string              `json:"error"`
+	Link              string              `json:"link,omitempty"`
+	DeveloperMessage  string              `json:"developer_message,omitempty"`
+	ErrorCode         int                 `json:"error_code,omitempty"`
+	InvalidParameters []*InvalidParameter `json:"invalid_parameters,omitempty"`
+
+	// url is the request URL redacted with the policy of the client, if known.
+	url string
+}
+
+// InvalidParameter describes a request field rejected by Vimeo.
+type InvalidParameter struct {
+	Field            string `json:"field,omitempty"`
+	Message          string `json:"error,omitempty"`
+	Link             string `json:"link,omitempty"`
+	DeveloperMessage string `json:"developer_message,omitempty"`
+	ErrorCode        int    `json:"error_code,omitempty"`
 }
 
 func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %v",
-		r.Response.Request.Method, sanitizeURL(r.Response.Request.URL),
+	msg := fmt.Sprintf("%v %v: %d %v",
+		r.Response.Request.Method, errorURL(r.url, r.Response.Request.URL),
 		r.Response.StatusCode, r.Message)
+
+	if len(r.InvalidParameters) > 0 {
+		fields := make([]string, 0, len(r.InvalidParameters))
+		for _, p := range r.InvalidParameters {
+			fields = append(fields, fmt.Sprintf("%s: %s", p.Field, p.Message))
+		}
+		msg += " (" + strings.Join(fields, "; ") + ")"
+	}
+
+	return msg
+}
+
+// Is reports whether the error matches one of the sentinel errors
+// ErrNotFound, ErrUnauthorized, ErrForbidden, ErrRateLimited or ErrValidation.
+func (r *ErrorResponse) Is(target error) bool {
+	if r.Response == nil {
+		return false
+	}
+
+	switch target {
+	case ErrNotFound:
+		return r.Response.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return r.Response.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return r.Response.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return r.Response.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return len(r.InvalidParameters) > 0
+	}
+
+	return false
 }
 
 // Rate represents the rate limit for the current client.
@@ -240,14 +404,30 @@ type RateLimitError struct {
 	Rate     Rate
 	Response *http.Response
 	Message  string
+
+	err *ErrorResponse
+	url string
 }
 
 func (r *RateLimitError) Error() string {
 	return fmt.Sprintf("%v %v: %d %v Reset in %v.",
-		r.Response.Request.Method, sanitizeURL(r.Response.Request.URL),
+		r.Response.Request.Method, errorURL(r.url, r.Response.Request.URL),
 		r.Response.StatusCode, r.Message, r.Rate.Reset)
 }
 
+// Is reports whether the target is ErrRateLimited.
+func (r *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// Unwrap returns the underlying ErrorResponse, holding the full error payload.
+func (r *RateLimitError) Unwrap() error {
+	if r.err == nil {
+		return nil
+	}
+	return r.err
+}
+
 // parseRate parses the rate related headers.
 func parseRate(r *http.Response) Rate {
 	var rate Rate
@@ -268,13 +448,30 @@ func parseRate(r *http.Response) Rate {
 	return rate
 }
 
+// errorURL returns the URL printed in an error message: the one redacted by the client,
+// or the request URL with the secret parameters of DefaultRedaction hidden when
+// the response was checked outside a client.
+func errorURL(redactedURL string, uri *url.URL) interface{} {
+	if redactedURL != "" {
+		return redactedURL
+	}
+	return sanitizeURL(uri)
+}
+
 func sanitizeURL(uri *url.URL) *url.URL {
 	if uri == nil {
 		return nil
 	}
 	params := uri.Query()
-	if len(params.Get("client_secret")) > 0 {
-		params.Set("client_secret", "REDACTED")
+	policy := DefaultRedaction()
+	changed := false
+	for k := range params {
+		if policy.queryParam(k) && len(params.Get(k)) > 0 {
+			params.Set(k, redacted)
+			changed = true
+		}
+	}
+	if changed {
 		uri.RawQuery = params.Encode()
 	}
 	return uri
@@ -282,6 +479,7 @@ func sanitizeURL(uri *url.URL) *url.URL {
 
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
+	response.Rate = parseRate(r)
 	return response
 }
 
@@ -296,12 +494,10 @@ func CheckResponse(r *http.Response) error {
 	}
 
 	errorResponse := &ErrorResponse{Response: r}
-	data, err := ioutil.ReadAll(r.Body)
-
-	if err == nil && data != nil {
-		err = json.Unmarshal(data, errorResponse)
-		if err != nil {
-			return err
+	if r.Body != nil {
+		data, err := ioutil.ReadAll(r.Body)
+		if err == nil && len(data) > 0 {
+			json.Unmarshal(data, errorResponse) // nolint: errcheck
 		}
 	}
 
@@ -310,12 +506,31 @@ func CheckResponse(r *http.Response) error {
 			Rate:     parseRate(r),
 			Response: errorResponse.Response,
 			Message:  errorResponse.Message,
+			err:      errorResponse,
 		}
 	}
 
 	return errorResponse
 }
 
+// checkResponse is the same as CheckResponse, but the error messages hide
+// the request URL according to the redaction policy of the client.
+func (c *Client) checkResponse(r *http.Response) error {
+	err := CheckResponse(r)
+	if r.Request == nil {
+		return err
+	}
+
+	switch err := err.(type) {
+	case *ErrorResponse:
+		err.url = c.redactURL(r.Request.URL)
+	case *RateLimitError:
+		err.url = c.redactURL(r.Request.URL)
+		err.err.url = err.url
+	}
+	return err
+}
+
 // CallOption is an optional argument to an API call.
 // A CallOption is something that configures an API call in a way that is not specific to that API: page, filter and etc
 type CallOption interface {
@@ -417,6 +632,12 @@ func (o OptWeakSearch) Get() (string, string) {
 	return "weak_search", fmt.Sprint(o)
 }
 
+// Bool allocates a new bool value to store v and returns a pointer to it,
+// for the optional settings of requests.
+func Bool(v bool) *bool {
+	return &v
+}
+
 func addOptions(s string, opts ...CallOption) (string, error) {
 	u, err := url.Parse(s)
 	if err != nil {
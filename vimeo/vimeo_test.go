@@ -3,6 +3,7 @@ package vimeo
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -113,6 +114,22 @@ func TestNewRequest(t *testing.T) {
 	}
 }
 
+func TestNewRequestWithContext(t *testing.T) {
+	c := NewClient(nil, nil)
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	req, err := c.NewRequestWithContext(ctx, "GET", "/test", nil)
+	if err != nil {
+		t.Fatalf("NewRequestWithContext returned unexpected error: %v", err)
+	}
+
+	if got := req.Context().Value(ctxKey{}); got != "value" {
+		t.Errorf("NewRequestWithContext context value is %v, want %v", got, "value")
+	}
+}
+
 func TestNewRequest_badURL(t *testing.T) {
 	c := NewClient(nil, nil)
 	_, err := c.NewRequest("GET", ":", nil)
@@ -143,7 +160,7 @@ func TestNewRequest_invalidJSON(t *testing.T) {
 	c := NewClient(nil, nil)
 
 	type T struct {
-		F map[interface{}]interface{}
+		F chan int
 	}
 
 	_, err := c.NewRequest("GET", "/", &T{})
@@ -257,6 +274,25 @@ func TestDo_ioWriter(t *testing.T) {
 	}
 }
 
+func TestDo_canceledContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"F":"v"}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := client.NewRequestWithContext(ctx, "GET", "/", nil)
+	_, err := client.Do(req, nil)
+
+	if err != context.Canceled {
+		t.Errorf("Do returned error %v, want %v", err, context.Canceled)
+	}
+}
+
 func TestPagination_GetPage(t *testing.T) {
 	p := pagination{Page: 1}
 	if page := p.GetPage(); page != 1 {